@@ -11,6 +11,8 @@ import (
 type AssertPredicate func(items []interface{}) error
 
 // RxAssert lists the Observable assertions.
+//
+// Deprecated: use the `rxtest` package instead.
 type RxAssert interface {
 	apply(*rxAssert)
 	itemsToBeChecked() (bool, []interface{})
@@ -189,6 +191,8 @@ func parseAssertions(assertions ...RxAssert) RxAssert {
 }
 
 // Assert asserts the result of an iterable against a list of assertions.
+//
+// Deprecated: it only supports the v2 `Iterable`, use the `rxtest` package to test an `Observable[T]`.
 func Assert(ctx context.Context, t *testing.T, iterable Iterable, assertions ...RxAssert) {
 	ass := parseAssertions(assertions...)
 
@@ -0,0 +1,122 @@
+package rxgo
+
+import (
+	"context"
+	"sync"
+)
+
+// FirstValue subscribes to the Observable and blocks until the first value arrives, then unsubscribes. It returns `ErrEmpty` if the Observable completes without emitting any value, and the context error if the context is done first.
+func FirstValue[T any](ctx context.Context, source Observable[T]) (T, error) {
+	var (
+		value T
+		found bool
+	)
+	err := consumeUntil(ctx, source, func(v T) bool {
+		value, found = v, true
+		return false
+	})
+	if err != nil {
+		return *new(T), err
+	}
+	if !found {
+		return *new(T), ErrEmpty
+	}
+	return value, nil
+}
+
+// LastValue subscribes to the Observable and blocks until it completes, returning the last value emitted. It returns `ErrEmpty` if the Observable completes without emitting any value, and the context error if the context is done first.
+func LastValue[T any](ctx context.Context, source Observable[T]) (T, error) {
+	var (
+		value T
+		found bool
+	)
+	err := consumeUntil(ctx, source, func(v T) bool {
+		value, found = v, true
+		return true
+	})
+	if err != nil {
+		return *new(T), err
+	}
+	if !found {
+		return *new(T), ErrEmpty
+	}
+	return value, nil
+}
+
+// Collect subscribes to the Observable and blocks until it completes, returning all the values emitted. An Observable completing without any value results in an empty slice.
+func Collect[T any](ctx context.Context, source Observable[T]) ([]T, error) {
+	var (
+		result = make([]T, 0)
+	)
+	err := consumeUntil(ctx, source, func(v T) bool {
+		result = append(result, v)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ForEach subscribes to the Observable and calls the callback for every value in the calling goroutine, blocking until the Observable completes. The error emitted by the Observable (or the context error) is returned.
+func ForEach[T any](ctx context.Context, source Observable[T], onNext OnNextFunc[T]) error {
+	if onNext == nil {
+		panic(`rxgo: "ForEach" expected onNext func`)
+	}
+	return consumeUntil(ctx, source, func(v T) bool {
+		onNext(v)
+		return true
+	})
+}
+
+// consumeUntil subscribes to the source and feeds every value to the callback
+// until it returns false, the source terminates or the context is done.
+func consumeUntil[T any](ctx context.Context, source Observable[T], onNext func(T) bool) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	var (
+		wg = new(sync.WaitGroup)
+	)
+
+	wg.Add(1)
+
+	var (
+		upStream = source.SubscribeOn(wg.Done)
+		err      error
+	)
+
+loop:
+	for {
+		select {
+		case <-ctx.Done():
+			// don't wait for the source to acknowledge the stop signal,
+			// the caller asked to return as soon as the context is done
+			upStream.Stop()
+			return ctx.Err()
+
+		case item, ok := <-upStream.ForEach():
+			if !ok {
+				break loop
+			}
+
+			if err = item.Err(); err != nil {
+				break loop
+			}
+
+			if item.Done() {
+				break loop
+			}
+
+			if !onNext(item.Value()) {
+				upStream.Stop()
+				break loop
+			}
+		}
+	}
+
+	wg.Wait()
+
+	return err
+}
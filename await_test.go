@@ -0,0 +1,133 @@
+package rxgo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFirstValue(t *testing.T) {
+	t.Run("FirstValue with Empty", func(t *testing.T) {
+		v, err := FirstValue(context.Background(), Empty[uint]())
+		require.Equal(t, ErrEmpty, err)
+		require.Equal(t, uint(0), v)
+	})
+
+	t.Run("FirstValue with error", func(t *testing.T) {
+		var err = errors.New("failed")
+		_, e := FirstValue(context.Background(), Throw[string](func() error {
+			return err
+		}))
+		require.Equal(t, err, e)
+	})
+
+	t.Run("FirstValue with values", func(t *testing.T) {
+		v, err := FirstValue(context.Background(), Of2("a", "b", "c"))
+		require.NoError(t, err)
+		require.Equal(t, "a", v)
+	})
+
+	t.Run("FirstValue with infinite stream", func(t *testing.T) {
+		v, err := FirstValue(context.Background(), Interval(time.Millisecond))
+		require.NoError(t, err)
+		require.Equal(t, uint(0), v)
+	})
+
+	t.Run("FirstValue with cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+		defer cancel()
+		_, err := FirstValue(ctx, Interval(time.Hour))
+		require.Equal(t, context.DeadlineExceeded, err)
+	})
+}
+
+func TestLastValue(t *testing.T) {
+	t.Run("LastValue with Empty", func(t *testing.T) {
+		_, err := LastValue(context.Background(), Empty[uint]())
+		require.Equal(t, ErrEmpty, err)
+	})
+
+	t.Run("LastValue with error", func(t *testing.T) {
+		var err = errors.New("failed")
+		_, e := LastValue(context.Background(), Scheduled[any]("a", err))
+		require.Equal(t, err, e)
+	})
+
+	t.Run("LastValue with values", func(t *testing.T) {
+		v, err := LastValue(context.Background(), Range[uint](1, 5))
+		require.NoError(t, err)
+		require.Equal(t, uint(5), v)
+	})
+
+	t.Run("LastValue with cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+		defer cancel()
+		_, err := LastValue(ctx, Interval(time.Millisecond))
+		require.Equal(t, context.DeadlineExceeded, err)
+	})
+}
+
+func TestCollect(t *testing.T) {
+	t.Run("Collect with Empty", func(t *testing.T) {
+		v, err := Collect(context.Background(), Empty[uint]())
+		require.NoError(t, err)
+		require.Equal(t, []uint{}, v)
+	})
+
+	t.Run("Collect with error", func(t *testing.T) {
+		var err = errors.New("failed")
+		v, e := Collect(context.Background(), Scheduled[any]("a", err))
+		require.Equal(t, err, e)
+		require.Nil(t, v)
+	})
+
+	t.Run("Collect with values", func(t *testing.T) {
+		v, err := Collect(context.Background(), Pipe1(
+			Range[uint](1, 5),
+			Map(func(v uint, _ uint) (uint, error) {
+				return v * 2, nil
+			}),
+		))
+		require.NoError(t, err)
+		require.Equal(t, []uint{2, 4, 6, 8, 10}, v)
+	})
+}
+
+func TestForEach(t *testing.T) {
+	t.Run("ForEach with values", func(t *testing.T) {
+		var result []string
+		err := ForEach(context.Background(), Of2("a", "b"), func(v string) {
+			result = append(result, v)
+		})
+		require.NoError(t, err)
+		require.Equal(t, []string{"a", "b"}, result)
+	})
+
+	t.Run("ForEach with error", func(t *testing.T) {
+		var (
+			err    = errors.New("failed")
+			result []any
+		)
+		e := ForEach(context.Background(), Scheduled[any]("a", err, "b"), func(v any) {
+			result = append(result, v)
+		})
+		require.Equal(t, err, e)
+		require.Equal(t, []any{"a"}, result)
+	})
+
+	t.Run("ForEach with cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		count := 0
+		err := ForEach(ctx, Interval(time.Millisecond), func(v uint) {
+			count++
+			if count == 3 {
+				cancel()
+			}
+		})
+		require.Equal(t, context.Canceled, err)
+		require.Equal(t, 3, count)
+	})
+}
@@ -0,0 +1,337 @@
+package rxgo
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// SingleObservable is an Observable which emits exactly one value, or an error.
+type SingleObservable[T any] interface {
+	// Subscribe to the stream asynchronously, either `onSuccess` or `onError` will be called.
+	Subscribe(onSuccess OnNextFunc[T], onError OnErrorFunc) Subscription
+	// Get blocks until the value is emitted, or the context is done.
+	Get(ctx context.Context) (T, error)
+	AsObservable() Observable[T]
+}
+
+// MaybeObservable is an Observable which emits at most one value, or an error.
+type MaybeObservable[T any] interface {
+	// Subscribe to the stream asynchronously, either `onSuccess`, `onError` or `onComplete` (if there is no value) will be called.
+	Subscribe(onSuccess OnNextFunc[T], onError OnErrorFunc, onComplete OnCompleteFunc) Subscription
+	// Get blocks until the stream terminates or the context is done, `None` is returned if the stream completes without value.
+	Get(ctx context.Context) (Optional[T], error)
+	AsObservable() Observable[T]
+}
+
+// Completable is an Observable without value, it only notifies completion or an error.
+type Completable interface {
+	// Subscribe to the stream asynchronously, either `onComplete` or `onError` will be called.
+	Subscribe(onComplete OnCompleteFunc, onError OnErrorFunc) Subscription
+	// Get blocks until the stream terminates or the context is done.
+	Get(ctx context.Context) error
+	AsObservable() Observable[any]
+}
+
+// Converts an Observable to a SingleObservable. The SingleObservable errors with `ErrEmpty` if the source completes without value, and with `ErrSequence` if the source emits more than one value.
+func ToSingle[T any](source Observable[T]) SingleObservable[T] {
+	return &singleObservable[T]{source: atMostOne(source, true)}
+}
+
+// Converts an Observable to a MaybeObservable. The MaybeObservable errors with `ErrSequence` if the source emits more than one value.
+func ToMaybe[T any](source Observable[T]) MaybeObservable[T] {
+	return &maybeObservable[T]{source: atMostOne(source, false)}
+}
+
+// Converts an Observable to a Completable, every value emitted by the source is ignored.
+func ToCompletable[T any](source Observable[T]) Completable {
+	return &completable{source: Pipe2(source, IgnoreElements[T](), Map(func(T, uint) (any, error) {
+		return nil, nil
+	}))}
+}
+
+// Returns an Observable which subscribes to next once the Completable completes.
+func AndThen[T any](c Completable, next Observable[T]) Observable[T] {
+	return newObservable(func(subscriber Subscriber[T]) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		go func() {
+			select {
+			case <-subscriber.Closed():
+				cancel()
+			case <-ctx.Done():
+			}
+		}()
+
+		if err := c.Get(ctx); err != nil {
+			// the subscriber unsubscribed while waiting
+			if ctx.Err() != nil {
+				return
+			}
+			Error[T](err).Send(subscriber)
+			return
+		}
+
+		var (
+			wg = new(sync.WaitGroup)
+		)
+
+		wg.Add(1)
+
+		var (
+			upStream = next.SubscribeOn(wg.Done)
+		)
+
+	loop:
+		for {
+			select {
+			case <-subscriber.Closed():
+				upStream.Stop()
+				break loop
+
+			case item, ok := <-upStream.ForEach():
+				if !ok {
+					break loop
+				}
+
+				item.Send(subscriber)
+				if item.IsEnd() {
+					break loop
+				}
+			}
+		}
+
+		wg.Wait()
+	})
+}
+
+// Counts the number of emissions on the source, see `Count`.
+func CountSingle[T any](source Observable[T], predicate ...PredicateFunc[T]) SingleObservable[uint] {
+	return ToSingle(Pipe1(source, Count(predicate...)))
+}
+
+// Applies an accumulator function over the source, see `Reduce`.
+func ReduceSingle[V any, A any](source Observable[V], accumulator AccumulatorFunc[A, V], seed A) SingleObservable[A] {
+	return ToSingle(Pipe1(source, Reduce(accumulator, seed)))
+}
+
+// Emits the single value at the specified index, see `ElementAt`.
+func ElementAtSingle[T any](source Observable[T], pos uint, defaultValue ...T) SingleObservable[T] {
+	return ToSingle(Pipe1(source, ElementAt(pos, defaultValue...)))
+}
+
+// Emits the first value (or the first value that meets some condition), see `First`.
+func FirstSingle[T any](source Observable[T], predicate PredicateFunc[T], defaultValue ...T) SingleObservable[T] {
+	return ToSingle(Pipe1(source, First(predicate, defaultValue...)))
+}
+
+// Emits the last value (or the last value that meets some condition), see `Last`.
+func LastSingle[T any](source Observable[T], predicate PredicateFunc[T], defaultValue ...T) SingleObservable[T] {
+	return ToSingle(Pipe1(source, Last(predicate, defaultValue...)))
+}
+
+// Emits the first value that meets some condition, or completes without value if none matches.
+func FindMaybe[T any](source Observable[T], predicate PredicateFunc[T]) MaybeObservable[T] {
+	return ToMaybe(Pipe2(source, Find(predicate), ConcatMap(func(v Optional[T], _ uint) Observable[T] {
+		if value, ok := v.Get(); ok {
+			return Of2(value)
+		}
+		return Empty[T]()
+	})))
+}
+
+type singleObservable[T any] struct {
+	source Observable[T]
+}
+
+var _ SingleObservable[any] = (*singleObservable[any])(nil)
+
+func (s *singleObservable[T]) Subscribe(onSuccess OnNextFunc[T], onError OnErrorFunc) Subscription {
+	return subscribeAsync(s.source, NewObserver(onSuccess, onError, nil))
+}
+
+func (s *singleObservable[T]) Get(ctx context.Context) (T, error) {
+	// the source guarantees a single value, we wait for the completion anyway
+	// to make sure the stream is not emitting a second one
+	return LastValue(ctx, s.source)
+}
+
+func (s *singleObservable[T]) AsObservable() Observable[T] {
+	return s.source
+}
+
+type maybeObservable[T any] struct {
+	source Observable[T]
+}
+
+var _ MaybeObservable[any] = (*maybeObservable[any])(nil)
+
+func (m *maybeObservable[T]) Subscribe(onSuccess OnNextFunc[T], onError OnErrorFunc, onComplete OnCompleteFunc) Subscription {
+	var (
+		emitted bool
+	)
+	if onSuccess == nil {
+		onSuccess = func(T) {}
+	}
+	if onComplete == nil {
+		onComplete = func() {}
+	}
+	return subscribeAsync(m.source, NewObserver(func(v T) {
+		emitted = true
+		onSuccess(v)
+	}, onError, func() {
+		if !emitted {
+			onComplete()
+		}
+	}))
+}
+
+func (m *maybeObservable[T]) Get(ctx context.Context) (Optional[T], error) {
+	v, err := LastValue(ctx, m.source)
+	if errors.Is(err, ErrEmpty) {
+		return None[T](), nil
+	}
+	if err != nil {
+		return None[T](), err
+	}
+	return Some(v), nil
+}
+
+func (m *maybeObservable[T]) AsObservable() Observable[T] {
+	return m.source
+}
+
+type completable struct {
+	source Observable[any]
+}
+
+var _ Completable = (*completable)(nil)
+
+func (c *completable) Subscribe(onComplete OnCompleteFunc, onError OnErrorFunc) Subscription {
+	return subscribeAsync(c.source, NewObserver[any](nil, onError, onComplete))
+}
+
+func (c *completable) Get(ctx context.Context) error {
+	return consumeUntil(ctx, c.source, func(any) bool {
+		return true
+	})
+}
+
+func (c *completable) AsObservable() Observable[any] {
+	return c.source
+}
+
+// atMostOne mirrors the source and makes sure it emits no more than one value.
+func atMostOne[T any](source Observable[T], required bool) Observable[T] {
+	return newObservable(func(subscriber Subscriber[T]) {
+		var (
+			wg = new(sync.WaitGroup)
+		)
+
+		wg.Add(1)
+
+		var (
+			upStream = source.SubscribeOn(wg.Done)
+			value    T
+			hasValue bool
+		)
+
+	loop:
+		for {
+			select {
+			case <-subscriber.Closed():
+				upStream.Stop()
+				break loop
+
+			case item, ok := <-upStream.ForEach():
+				if !ok {
+					break loop
+				}
+
+				if err := item.Err(); err != nil {
+					Error[T](err).Send(subscriber)
+					break loop
+				}
+
+				if item.Done() {
+					if hasValue {
+						Next(value).Send(subscriber)
+					} else if required {
+						Error[T](ErrEmpty).Send(subscriber)
+						break loop
+					}
+					Complete[T]().Send(subscriber)
+					break loop
+				}
+
+				if hasValue {
+					upStream.Stop()
+					Error[T](ErrSequence).Send(subscriber)
+					break loop
+				}
+
+				value, hasValue = item.Value(), true
+			}
+		}
+
+		wg.Wait()
+	})
+}
+
+type subscription struct {
+	stop func()
+}
+
+func (s *subscription) Unsubscribe() {
+	s.stop()
+}
+
+// subscribeAsync subscribes to the source and dispatches the notifications to
+// the observer on a separate goroutine.
+func subscribeAsync[T any](source Observable[T], observer Observer[T]) Subscription {
+	upStream := source.SubscribeOn()
+	go func() {
+		var (
+			terminated bool
+		)
+
+		defer func() {
+			// a panic in `onNext` is reported to `onError`
+			if r := recover(); r != nil {
+				if terminated {
+					panic(r)
+				}
+				upStream.Stop()
+				observer.Error(newPanicError(r))
+			}
+		}()
+
+		for {
+			select {
+			case <-upStream.Closed():
+				return
+
+			case item, ok := <-upStream.ForEach():
+				if !ok {
+					return
+				}
+
+				if err := item.Err(); err != nil {
+					terminated = true
+					observer.Error(err)
+					return
+				}
+
+				if item.Done() {
+					terminated = true
+					observer.Complete()
+					return
+				}
+
+				observer.Next(item.Value())
+			}
+		}
+	}()
+	return &subscription{stop: upStream.Stop}
+}
@@ -0,0 +1,210 @@
+package rxgo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestToSingle(t *testing.T) {
+	t.Run("ToSingle with Empty", func(t *testing.T) {
+		_, err := ToSingle(Empty[uint]()).Get(context.Background())
+		require.Equal(t, ErrEmpty, err)
+		checkObservableResults(t, ToSingle(Empty[uint]()).AsObservable(), []uint{}, ErrEmpty, false)
+	})
+
+	t.Run("ToSingle with error", func(t *testing.T) {
+		var err = errors.New("failed")
+		_, e := ToSingle(Throw[uint](func() error {
+			return err
+		})).Get(context.Background())
+		require.Equal(t, err, e)
+	})
+
+	t.Run("ToSingle with one value", func(t *testing.T) {
+		v, err := ToSingle(Of2("a")).Get(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, "a", v)
+		checkObservableResults(t, ToSingle(Of2("a")).AsObservable(), []string{"a"}, nil, true)
+	})
+
+	t.Run("ToSingle with too many values", func(t *testing.T) {
+		_, err := ToSingle(Of2("a", "b")).Get(context.Background())
+		require.Equal(t, ErrSequence, err)
+	})
+
+	t.Run("ToSingle with cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+		defer cancel()
+		_, err := ToSingle(Interval(time.Hour)).Get(ctx)
+		require.Equal(t, context.DeadlineExceeded, err)
+	})
+
+	t.Run("ToSingle with Subscribe", func(t *testing.T) {
+		var (
+			result = make(chan uint, 1)
+			failed = make(chan error, 1)
+		)
+		ToSingle(Range[uint](7, 1)).Subscribe(func(v uint) {
+			result <- v
+		}, func(err error) {
+			failed <- err
+		})
+		require.Equal(t, uint(7), <-result)
+
+		ToSingle(Range[uint](7, 2)).Subscribe(func(v uint) {
+			result <- v
+		}, func(err error) {
+			failed <- err
+		})
+		require.Equal(t, ErrSequence, <-failed)
+	})
+
+	t.Run("ToSingle with Unsubscribe", func(t *testing.T) {
+		var called = make(chan struct{}, 2)
+		sub := ToSingle(Interval(time.Millisecond*100)).Subscribe(func(v uint) {
+			called <- struct{}{}
+		}, func(err error) {
+			called <- struct{}{}
+		})
+		sub.Unsubscribe()
+		time.Sleep(time.Millisecond * 150)
+		require.Len(t, called, 0)
+	})
+}
+
+func TestToMaybe(t *testing.T) {
+	t.Run("ToMaybe with Empty", func(t *testing.T) {
+		v, err := ToMaybe(Empty[uint]()).Get(context.Background())
+		require.NoError(t, err)
+		require.True(t, v.IsNone())
+	})
+
+	t.Run("ToMaybe with error", func(t *testing.T) {
+		var err = errors.New("failed")
+		_, e := ToMaybe(Throw[uint](func() error {
+			return err
+		})).Get(context.Background())
+		require.Equal(t, err, e)
+	})
+
+	t.Run("ToMaybe with one value", func(t *testing.T) {
+		v, err := ToMaybe(Of2("a")).Get(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, Some("a"), v)
+	})
+
+	t.Run("ToMaybe with too many values", func(t *testing.T) {
+		_, err := ToMaybe(Of2("a", "b")).Get(context.Background())
+		require.Equal(t, ErrSequence, err)
+	})
+
+	t.Run("ToMaybe with Subscribe", func(t *testing.T) {
+		var done = make(chan string, 2)
+		ToMaybe(Of2("a")).Subscribe(func(v string) {
+			done <- v
+		}, nil, func() {
+			done <- "complete"
+		})
+		require.Equal(t, "a", <-done)
+
+		ToMaybe(Empty[string]()).Subscribe(func(v string) {
+			done <- v
+		}, nil, func() {
+			done <- "complete"
+		})
+		require.Equal(t, "complete", <-done)
+
+		time.Sleep(time.Millisecond * 10)
+		require.Len(t, done, 0)
+	})
+}
+
+func TestToCompletable(t *testing.T) {
+	t.Run("ToCompletable with values", func(t *testing.T) {
+		require.NoError(t, ToCompletable(Range[uint](1, 10)).Get(context.Background()))
+		checkObservableResults(t, ToCompletable(Range[uint](1, 10)).AsObservable(), []any{}, nil, true)
+	})
+
+	t.Run("ToCompletable with error", func(t *testing.T) {
+		var err = errors.New("failed")
+		require.Equal(t, err, ToCompletable(Scheduled[any]("a", err)).Get(context.Background()))
+	})
+
+	t.Run("ToCompletable with Subscribe", func(t *testing.T) {
+		var done = make(chan struct{})
+		ToCompletable(Of2(1, 2)).Subscribe(func() {
+			close(done)
+		}, nil)
+		<-done
+	})
+
+	t.Run("AndThen", func(t *testing.T) {
+		checkObservableResults(t, AndThen(
+			ToCompletable(Of2("x", "y")),
+			Of2(1, 2, 3),
+		), []int{1, 2, 3}, nil, true)
+	})
+
+	t.Run("AndThen with error", func(t *testing.T) {
+		var err = errors.New("failed")
+		checkObservableResults(t, AndThen(
+			ToCompletable(Scheduled[any]("a", err)),
+			Of2(1, 2, 3),
+		), []int{}, err, false)
+	})
+}
+
+func TestSingleVariants(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("CountSingle", func(t *testing.T) {
+		v, err := CountSingle(Range[uint](1, 7)).Get(ctx)
+		require.NoError(t, err)
+		require.Equal(t, uint(7), v)
+	})
+
+	t.Run("ReduceSingle", func(t *testing.T) {
+		v, err := ReduceSingle(Range[uint](1, 4), func(acc, v, _ uint) (uint, error) {
+			return acc + v, nil
+		}, 0).Get(ctx)
+		require.NoError(t, err)
+		require.Equal(t, uint(10), v)
+	})
+
+	t.Run("ElementAtSingle", func(t *testing.T) {
+		v, err := ElementAtSingle(Of2("a", "b", "c"), 1).Get(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "b", v)
+
+		_, err = ElementAtSingle(Of2("a"), 5).Get(ctx)
+		require.Equal(t, ErrArgumentOutOfRange, err)
+	})
+
+	t.Run("FirstSingle and LastSingle", func(t *testing.T) {
+		v, err := FirstSingle(Range[uint](1, 5), nil).Get(ctx)
+		require.NoError(t, err)
+		require.Equal(t, uint(1), v)
+
+		v, err = LastSingle(Range[uint](1, 5), nil).Get(ctx)
+		require.NoError(t, err)
+		require.Equal(t, uint(5), v)
+	})
+
+	t.Run("FindMaybe", func(t *testing.T) {
+		v, err := FindMaybe(Of2("a", "b", "c"), func(v string, _ uint) bool {
+			return v == "b"
+		}).Get(ctx)
+		require.NoError(t, err)
+		require.Equal(t, Some("b"), v)
+
+		v, err = FindMaybe(Of2("a", "b", "c"), func(v string, _ uint) bool {
+			return v == "z"
+		}).Get(ctx)
+		require.NoError(t, err)
+		require.True(t, v.IsNone())
+	})
+}
@@ -0,0 +1,345 @@
+package rxgo
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/csv"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// Codec converts a value of type T from and to its binary representation.
+type Codec[T any] interface {
+	Marshal(value T) ([]byte, error)
+	Unmarshal(data []byte) (T, error)
+}
+
+// Marshal transforms the items emitted by an Observable by applying the codec encoder to each item.
+func Marshal[T any](codec Codec[T]) OperatorFunc[T, []byte] {
+	if codec == nil {
+		panic(`rxgo: "Marshal" expected codec`)
+	}
+	return func(source Observable[T]) Observable[[]byte] {
+		return createOperatorFunc(
+			source,
+			func(obs Observer[[]byte], v T) {
+				output, err := codec.Marshal(v)
+				if err != nil {
+					obs.Error(err)
+					return
+				}
+				obs.Next(output)
+			},
+			func(obs Observer[[]byte], err error) {
+				obs.Error(err)
+			},
+			func(obs Observer[[]byte]) {
+				obs.Complete()
+			},
+		)
+	}
+}
+
+// Unmarshal transforms the items emitted by an Observable by applying the codec decoder to each item. If an item cannot be decoded, the output Observable errors with an `UnmarshalError` carrying the offending bytes.
+func Unmarshal[T any](codec Codec[T]) OperatorFunc[[]byte, T] {
+	if codec == nil {
+		panic(`rxgo: "Unmarshal" expected codec`)
+	}
+	return func(source Observable[[]byte]) Observable[T] {
+		return createOperatorFunc(
+			source,
+			func(obs Observer[T], v []byte) {
+				output, err := codec.Unmarshal(v)
+				if err != nil {
+					obs.Error(UnmarshalError{Data: v, Err: err})
+					return
+				}
+				obs.Next(output)
+			},
+			func(obs Observer[T], err error) {
+				obs.Error(err)
+			},
+			func(obs Observer[T]) {
+				obs.Complete()
+			},
+		)
+	}
+}
+
+type jsonCodec[T any] struct {
+	newLine bool
+}
+
+var _ Codec[any] = (*jsonCodec[any])(nil)
+
+// JSONCodec returns a codec using `encoding/json`.
+func JSONCodec[T any]() Codec[T] {
+	return jsonCodec[T]{}
+}
+
+// NDJSONCodec returns a codec using `encoding/json` where every encoded value is terminated by a new line, see http://ndjson.org. It pairs with `FromReader` to decode a newline-delimited JSON stream.
+func NDJSONCodec[T any]() Codec[T] {
+	return jsonCodec[T]{newLine: true}
+}
+
+func (c jsonCodec[T]) Marshal(value T) ([]byte, error) {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	if c.newLine {
+		b = append(b, '\n')
+	}
+	return b, nil
+}
+
+func (c jsonCodec[T]) Unmarshal(data []byte) (T, error) {
+	var (
+		value T
+	)
+	if c.newLine {
+		data = bytes.TrimRight(data, "\r\n")
+	}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return *new(T), err
+	}
+	return value, nil
+}
+
+type gobCodec[T any] struct{}
+
+var _ Codec[any] = (*gobCodec[any])(nil)
+
+// GobCodec returns a codec using `encoding/gob`. Every value is encoded independently, so the type information is part of every encoded item.
+func GobCodec[T any]() Codec[T] {
+	return gobCodec[T]{}
+}
+
+func (gobCodec[T]) Marshal(value T) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	if err := gob.NewEncoder(buf).Encode(value); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (gobCodec[T]) Unmarshal(data []byte) (T, error) {
+	var (
+		value T
+	)
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&value); err != nil {
+		return *new(T), err
+	}
+	return value, nil
+}
+
+type CSVConfig struct {
+	// The field delimiter, it's ',' by default.
+	Comma rune
+	// The column names of the records. If it's provided, columns will be mapped to the struct fields with the same `csv` tag (or field name), otherwise columns are mapped to the fields in the order they are declared.
+	Header []string
+}
+
+type csvCodec[T any] struct {
+	comma rune
+	// index of the struct field for every column, -1 if the column should be ignored
+	fields []int
+	// the value is a raw `[]string` record
+	raw bool
+}
+
+var _ Codec[any] = (*csvCodec[any])(nil)
+
+// CSVCodec returns a codec converting a single CSV record from and to a struct (or a `[]string`). The exported fields of the struct can be renamed with the `csv` tag, or skipped with `csv:"-"`. Fields must be strings, booleans, numbers or implement `encoding.TextMarshaler` and `encoding.TextUnmarshaler`.
+func CSVCodec[T any](config ...CSVConfig) Codec[T] {
+	var (
+		cfg = CSVConfig{Comma: ','}
+	)
+	if len(config) > 0 {
+		cfg = config[0]
+		if cfg.Comma == 0 {
+			cfg.Comma = ','
+		}
+	}
+
+	codec := csvCodec[T]{comma: cfg.Comma}
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	if typ == reflect.TypeOf([]string(nil)) {
+		codec.raw = true
+		return codec
+	}
+
+	if typ.Kind() != reflect.Struct {
+		panic(`rxgo: "CSVCodec" expected struct or []string type`)
+	}
+
+	var (
+		names   = make([]string, 0, typ.NumField())
+		indexes = make([]int, 0, typ.NumField())
+	)
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := field.Name
+		if tag, ok := field.Tag.Lookup("csv"); ok {
+			if tag == "-" {
+				continue
+			}
+			if tag != "" {
+				name = tag
+			}
+		}
+		names = append(names, name)
+		indexes = append(indexes, i)
+	}
+
+	if len(cfg.Header) == 0 {
+		codec.fields = indexes
+		return codec
+	}
+
+	codec.fields = make([]int, len(cfg.Header))
+	for i, column := range cfg.Header {
+		codec.fields[i] = -1
+		for j, name := range names {
+			if name == column {
+				codec.fields[i] = indexes[j]
+				break
+			}
+		}
+	}
+	return codec
+}
+
+func (c csvCodec[T]) Marshal(value T) ([]byte, error) {
+	var (
+		record []string
+	)
+	if c.raw {
+		record = any(value).([]string)
+	} else {
+		v := reflect.ValueOf(&value).Elem()
+		record = make([]string, len(c.fields))
+		for i, idx := range c.fields {
+			if idx < 0 {
+				continue
+			}
+			s, err := formatCSVField(v.Field(idx))
+			if err != nil {
+				return nil, err
+			}
+			record[i] = s
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	w := csv.NewWriter(buf)
+	w.Comma = c.comma
+	if err := w.Write(record); err != nil {
+		return nil, err
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (c csvCodec[T]) Unmarshal(data []byte) (T, error) {
+	var (
+		value T
+	)
+	r := csv.NewReader(bytes.NewReader(data))
+	r.Comma = c.comma
+	r.FieldsPerRecord = -1
+	record, err := r.Read()
+	if err != nil {
+		return *new(T), err
+	}
+
+	if c.raw {
+		return any(record).(T), nil
+	}
+
+	if len(record) != len(c.fields) {
+		return *new(T), fmt.Errorf("rxgo: expected %d columns but got %d", len(c.fields), len(record))
+	}
+
+	v := reflect.ValueOf(&value).Elem()
+	for i, idx := range c.fields {
+		if idx < 0 {
+			continue
+		}
+		if err := parseCSVField(v.Field(idx), record[i]); err != nil {
+			return *new(T), fmt.Errorf("rxgo: column %d: %w", i, err)
+		}
+	}
+	return value, nil
+}
+
+func formatCSVField(v reflect.Value) (string, error) {
+	if m, ok := v.Interface().(encoding.TextMarshaler); ok {
+		b, err := m.MarshalText()
+		return string(b), err
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()), nil
+	}
+	return "", fmt.Errorf("rxgo: unsupported csv field type %s", v.Type())
+}
+
+func parseCSVField(v reflect.Value, s string) error {
+	if u, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(s))
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+		return nil
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(n)
+		return nil
+	}
+	return fmt.Errorf("rxgo: unsupported csv field type %s", v.Type())
+}
@@ -0,0 +1,182 @@
+package rxgo
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type customer struct {
+	ID   int    `json:"id" csv:"id"`
+	Name string `json:"name" csv:"name"`
+	VIP  bool   `json:"vip,omitempty" csv:"vip"`
+	note string
+}
+
+func TestMarshal(t *testing.T) {
+	t.Run("Marshal with Empty", func(t *testing.T) {
+		checkObservableResults(t, Pipe1(
+			Empty[customer](),
+			Marshal(JSONCodec[customer]()),
+		), [][]byte{}, nil, true)
+	})
+
+	t.Run("Marshal with error", func(t *testing.T) {
+		var err = errors.New("failed")
+		checkObservableResults(t, Pipe1(
+			Throw[customer](func() error {
+				return err
+			}),
+			Marshal(JSONCodec[customer]()),
+		), [][]byte{}, err, false)
+	})
+
+	t.Run("Marshal with JSONCodec", func(t *testing.T) {
+		checkObservableResults(t, Pipe1(
+			Of2(customer{ID: 1, Name: "Alice"}, customer{ID: 2, Name: "Bob", VIP: true}),
+			Marshal(JSONCodec[customer]()),
+		), [][]byte{
+			[]byte(`{"id":1,"name":"Alice"}`),
+			[]byte(`{"id":2,"name":"Bob","vip":true}`),
+		}, nil, true)
+	})
+
+	t.Run("Marshal with NDJSONCodec", func(t *testing.T) {
+		checkObservableResults(t, Pipe1(
+			Of2(customer{ID: 1, Name: "Alice"}),
+			Marshal(NDJSONCodec[customer]()),
+		), [][]byte{[]byte("{\"id\":1,\"name\":\"Alice\"}\n")}, nil, true)
+	})
+
+	t.Run("Marshal with CSVCodec", func(t *testing.T) {
+		checkObservableResults(t, Pipe1(
+			Of2(customer{ID: 1, Name: "Alice, Jr.", note: "hidden"}),
+			Marshal(CSVCodec[customer]()),
+		), [][]byte{[]byte("1,\"Alice, Jr.\",false\n")}, nil, true)
+	})
+
+	t.Run("Marshal with CSVCodec and header", func(t *testing.T) {
+		checkObservableResults(t, Pipe1(
+			Of2(customer{ID: 1, Name: "Alice", VIP: true}),
+			Marshal(CSVCodec[customer](CSVConfig{Comma: ';', Header: []string{"vip", "unknown", "id"}})),
+		), [][]byte{[]byte("true;;1\n")}, nil, true)
+	})
+
+	t.Run("Marshal with encode error", func(t *testing.T) {
+		var err error
+		Pipe1(
+			Of2[any](func() {}),
+			Marshal(JSONCodec[any]()),
+		).SubscribeSync(nil, func(e error) {
+			err = e
+		}, nil)
+		require.Error(t, err)
+	})
+}
+
+func TestUnmarshal(t *testing.T) {
+	t.Run("Unmarshal with Empty", func(t *testing.T) {
+		checkObservableResults(t, Pipe1(
+			Empty[[]byte](),
+			Unmarshal(JSONCodec[customer]()),
+		), []customer{}, nil, true)
+	})
+
+	t.Run("Unmarshal with JSONCodec", func(t *testing.T) {
+		checkObservableResults(t, Pipe1(
+			Of2([]byte(`{"id":1,"name":"Alice"}`), []byte(`{"id":2,"name":"Bob","vip":true}`)),
+			Unmarshal(JSONCodec[customer]()),
+		), []customer{{ID: 1, Name: "Alice"}, {ID: 2, Name: "Bob", VIP: true}}, nil, true)
+	})
+
+	t.Run("Unmarshal with NDJSONCodec and FromReader", func(t *testing.T) {
+		checkObservableResults(t, Pipe1(
+			FromReader(strings.NewReader("{\"id\":1}\n{\"id\":2}\n"), nil),
+			Unmarshal(NDJSONCodec[customer]()),
+		), []customer{{ID: 1}, {ID: 2}}, nil, true)
+	})
+
+	t.Run("Unmarshal with CSVCodec", func(t *testing.T) {
+		checkObservableResults(t, Pipe1(
+			Of2([]byte("1,\"Alice, Jr.\",true\n"), []byte("2,Bob,false")),
+			Unmarshal(CSVCodec[customer]()),
+		), []customer{{ID: 1, Name: "Alice, Jr.", VIP: true}, {ID: 2, Name: "Bob"}}, nil, true)
+	})
+
+	t.Run("Unmarshal with CSVCodec and header", func(t *testing.T) {
+		checkObservableResults(t, Pipe1(
+			Of2([]byte("Bob|ignored|7")),
+			Unmarshal(CSVCodec[customer](CSVConfig{Comma: '|', Header: []string{"name", "other", "id"}})),
+		), []customer{{ID: 7, Name: "Bob"}}, nil, true)
+	})
+
+	t.Run("Unmarshal with CSVCodec and raw record", func(t *testing.T) {
+		checkObservableResults(t, Pipe1(
+			Of2([]byte("a,b,c")),
+			Unmarshal(CSVCodec[[]string]()),
+		), [][]string{{"a", "b", "c"}}, nil, true)
+	})
+
+	t.Run("Unmarshal with GobCodec", func(t *testing.T) {
+		codec := GobCodec[customer]()
+		b, err := codec.Marshal(customer{ID: 3, Name: "Carol"})
+		require.NoError(t, err)
+		checkObservableResults(t, Pipe1(
+			Of2(b),
+			Unmarshal(codec),
+		), []customer{{ID: 3, Name: "Carol"}}, nil, true)
+	})
+
+	t.Run("Unmarshal with text unmarshaler", func(t *testing.T) {
+		type event struct {
+			At time.Time
+		}
+		at := time.Date(2022, 9, 1, 10, 0, 0, 0, time.UTC)
+		checkObservableResults(t, Pipe2(
+			Of2(event{At: at}),
+			Marshal(CSVCodec[event]()),
+			Unmarshal(CSVCodec[event]()),
+		), []event{{At: at}}, nil, true)
+	})
+
+	t.Run("Unmarshal with decode error", func(t *testing.T) {
+		var (
+			result []customer
+			err    error
+		)
+		Pipe1(
+			Of2([]byte(`{"id":1}`), []byte(`{"id":`), []byte(`{"id":3}`)),
+			Unmarshal(JSONCodec[customer]()),
+		).SubscribeSync(func(v customer) {
+			result = append(result, v)
+		}, func(e error) {
+			err = e
+		}, nil)
+		require.Equal(t, []customer{{ID: 1}}, result)
+
+		var unmarshalErr UnmarshalError
+		require.True(t, errors.As(err, &unmarshalErr))
+		require.Equal(t, []byte(`{"id":`), unmarshalErr.Data)
+		require.NotNil(t, errors.Unwrap(err))
+	})
+
+	t.Run("Unmarshal with CSV column mismatch", func(t *testing.T) {
+		var err error
+		Pipe1(
+			Of2([]byte("1,Alice")),
+			Unmarshal(CSVCodec[customer]()),
+		).SubscribeSync(nil, func(e error) {
+			err = e
+		}, nil)
+		require.ErrorAs(t, err, &UnmarshalError{})
+	})
+
+	t.Run("CSVCodec with unsupported type", func(t *testing.T) {
+		require.Panics(t, func() {
+			CSVCodec[int]()
+		})
+	})
+}
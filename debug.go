@@ -0,0 +1,187 @@
+package rxgo
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// DebugConfig configures the debug mode, see `EnableDebug`.
+type DebugConfig struct {
+	// CaptureValues attaches the item being processed to the `OperatorError`, beware it retains the item.
+	CaptureValues bool
+	// MaxStackDepth is the maximum number of frames captured, 32 by default.
+	MaxStackDepth int
+}
+
+var debugConfig atomic.Pointer[DebugConfig]
+
+// EnableDebug enables the debug mode: the call site of every Observable and operator is captured when it's created, and the errors are wrapped into an `OperatorError` locating the operator which produced them. It only applies to the Observables created afterwards, and it has a cost, it's meant for development.
+func EnableDebug(config ...DebugConfig) {
+	var cfg DebugConfig
+	if len(config) > 0 {
+		cfg = config[0]
+	}
+	if cfg.MaxStackDepth <= 0 {
+		cfg.MaxStackDepth = 32
+	}
+	debugConfig.Store(&cfg)
+}
+
+// DisableDebug disables the debug mode.
+func DisableDebug() {
+	debugConfig.Store(nil)
+}
+
+var (
+	// rxgoPackage prefixes the name of the functions of this package
+	rxgoPackage = reflect.TypeOf(OperatorError{}).PkgPath() + "."
+	// rxgoDir is the directory of the source files of this package
+	rxgoDir = func() string {
+		_, file, _, _ := runtime.Caller(0)
+		return filepath.Dir(file)
+	}()
+)
+
+// assembly describes where an Observable was created.
+type assembly struct {
+	operator string
+	location string
+	stack    []byte
+	config   DebugConfig
+}
+
+// newAssembly captures the current call site, it returns nil unless the debug
+// mode is enabled.
+func newAssembly(skip int) *assembly {
+	cfg := debugConfig.Load()
+	if cfg == nil {
+		return nil
+	}
+
+	a := &assembly{config: *cfg}
+	a.operator, a.location, a.stack = callSite(skip+1, cfg.MaxStackDepth)
+	return a
+}
+
+// callSite walks the stack of the caller, skipping the frames of this package,
+// to find the operator called by the user and where it was called.
+func callSite(skip int, depth int) (operator string, location string, stack []byte) {
+	var (
+		pcs    = make([]uintptr, depth)
+		frames = runtime.CallersFrames(pcs[:runtime.Callers(skip+2, pcs)])
+		buf    bytes.Buffer
+		user   bool
+	)
+
+	for {
+		frame, more := frames.Next()
+		if !user && isLibraryFrame(frame) {
+			// the outermost operator of this package is the one the user called
+			if name := operatorName(frame.Function); name != "" {
+				operator = name
+			}
+		} else {
+			if !user {
+				user = true
+				location = fmt.Sprintf("%s:%d", frame.File, frame.Line)
+			}
+			fmt.Fprintf(&buf, "%s\n\t%s:%d\n", frame.Function, frame.File, frame.Line)
+		}
+		if !more {
+			break
+		}
+	}
+
+	if operator == "" {
+		operator = "Observable"
+	}
+	return operator, location, buf.Bytes()
+}
+
+func isLibraryFrame(frame runtime.Frame) bool {
+	return filepath.Dir(frame.File) == rxgoDir && !strings.HasSuffix(frame.File, "_test.go")
+}
+
+// operatorName extracts the name of an exported function of this package, such
+// as `Map` from "github.com/reactivex/rxgo/v3.Map[...].func1". The pipes are
+// ignored, they don't create any Observable.
+func operatorName(function string) string {
+	if !strings.HasPrefix(function, rxgoPackage) {
+		return ""
+	}
+	name := strings.TrimPrefix(function, rxgoPackage)
+	if i := strings.IndexAny(name, "[."); i >= 0 {
+		name = name[:i]
+	}
+	if name == "" || name[0] < 'A' || name[0] > 'Z' || strings.HasPrefix(name, "Pipe") {
+		return ""
+	}
+	return name
+}
+
+// operatorState tracks the items of a subscriber in debug mode, to locate the
+// errors it receives.
+type operatorState struct {
+	assembly *assembly
+	mu       sync.Mutex
+	index    uint
+	value    any
+	tracked  bool
+	emitted  uint
+}
+
+// debugTracker is implemented by the subscribers created in debug mode.
+type debugTracker interface {
+	operatorState() *operatorState
+}
+
+// trackItem records the item being processed by an operator, if the
+// subscriber is in debug mode. The value is only boxed when it's captured.
+func trackItem[T any, V any](subscriber Subscriber[T], index uint, value V) {
+	if t, ok := subscriber.(debugTracker); ok {
+		if s := t.operatorState(); s != nil {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			s.index, s.tracked = index, true
+			if s.assembly.config.CaptureValues {
+				s.value = value
+			}
+		}
+	}
+}
+
+func (s *operatorState) next() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.emitted++
+}
+
+// wrap wraps the error into an `OperatorError`, unless it already locates the
+// operator which produced it upstream.
+func (s *operatorState) wrap(err error) error {
+	var opErr OperatorError
+	if errors.As(err, &opErr) {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	opErr = OperatorError{
+		Operator: s.assembly.operator,
+		Location: s.assembly.location,
+		Stack:    s.assembly.stack,
+		Index:    s.emitted,
+		Err:      err,
+	}
+	if s.tracked {
+		opErr.Index, opErr.Value = s.index, s.value
+	}
+	return opErr
+}
@@ -0,0 +1,141 @@
+package rxgo
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDebug(t *testing.T) {
+	errFailed := errors.New("failed")
+	failAt := func(n int) OperatorFunc[int, int] {
+		return Map(func(v int, _ uint) (int, error) {
+			if v == n {
+				return 0, errFailed
+			}
+			return v, nil
+		})
+	}
+
+	subscribeErr := func(obs Observable[int]) (err error) {
+		obs.SubscribeSync(nil, func(e error) {
+			err = e
+		}, nil)
+		return
+	}
+
+	t.Run("Disabled", func(t *testing.T) {
+		err := subscribeErr(Pipe1(Of2(1, 2, 3), failAt(2)))
+		require.Equal(t, errFailed, err)
+	})
+
+	t.Run("OperatorError with Map", func(t *testing.T) {
+		EnableDebug()
+		t.Cleanup(DisableDebug)
+
+		err := subscribeErr(Pipe1(Of2(1, 2, 3), failAt(3)))
+		require.ErrorIs(t, err, errFailed)
+
+		var opErr OperatorError
+		require.ErrorAs(t, err, &opErr)
+		require.Equal(t, "Map", opErr.Operator)
+		require.Contains(t, opErr.Location, "debug_test.go")
+		require.Contains(t, string(opErr.Stack), "TestDebug")
+		require.Equal(t, uint(2), opErr.Index)
+		require.Nil(t, opErr.Value)
+		require.True(t, strings.HasPrefix(err.Error(), "Map ("))
+	})
+
+	t.Run("OperatorError with captured value", func(t *testing.T) {
+		EnableDebug(DebugConfig{CaptureValues: true})
+		t.Cleanup(DisableDebug)
+
+		var opErr OperatorError
+		require.ErrorAs(t, subscribeErr(Pipe1(Of2(1, 2, 3), failAt(2))), &opErr)
+		require.Equal(t, uint(1), opErr.Index)
+		require.Equal(t, 2, opErr.Value)
+	})
+
+	t.Run("OperatorError locates the origin", func(t *testing.T) {
+		EnableDebug()
+		t.Cleanup(DisableDebug)
+
+		err := subscribeErr(Pipe3(
+			Of2(1, 2, 3),
+			failAt(1),
+			Filter(func(int, uint) bool {
+				return true
+			}),
+			Take[int](5),
+		))
+
+		var opErr OperatorError
+		require.ErrorAs(t, err, &opErr)
+		require.Equal(t, "Map", opErr.Operator)
+		require.Equal(t, uint(0), opErr.Index)
+		require.ErrorIs(t, err, errFailed)
+	})
+
+	t.Run("OperatorError with ErrEmpty", func(t *testing.T) {
+		EnableDebug()
+		t.Cleanup(DisableDebug)
+
+		err := subscribeErr(Pipe1(Empty[int](), First[int](nil)))
+		require.ErrorIs(t, err, ErrEmpty)
+
+		var opErr OperatorError
+		require.ErrorAs(t, err, &opErr)
+		require.Equal(t, "First", opErr.Operator)
+	})
+
+	t.Run("OperatorError with panic", func(t *testing.T) {
+		EnableDebug()
+		t.Cleanup(DisableDebug)
+
+		err := subscribeErr(Pipe1(Of2(1, 2), Map(func(v int, _ uint) (int, error) {
+			if v == 2 {
+				panic("boom")
+			}
+			return v, nil
+		})))
+		require.ErrorAs(t, err, &PanicError{})
+
+		var opErr OperatorError
+		require.ErrorAs(t, err, &opErr)
+		require.Equal(t, "Map", opErr.Operator)
+		require.Equal(t, uint(1), opErr.Index)
+	})
+
+	t.Run("OperatorError with FilterCtx", func(t *testing.T) {
+		EnableDebug()
+		t.Cleanup(DisableDebug)
+
+		err := subscribeErr(Pipe1(Of2(1, 2, 3, 4), FilterCtx(func(_ context.Context, v int, _ uint) bool {
+			if v == 4 {
+				panic("boom")
+			}
+			return v%2 == 0
+		})))
+
+		var opErr OperatorError
+		require.ErrorAs(t, err, &opErr)
+		require.Equal(t, "FilterCtx", opErr.Operator)
+		require.Equal(t, uint(3), opErr.Index)
+	})
+
+	t.Run("OperatorError with WithHooks", func(t *testing.T) {
+		EnableDebug()
+		t.Cleanup(DisableDebug)
+
+		recorder := new(hooksRecorder)
+		err := subscribeErr(Pipe2(Of2(1), Map(func(int, uint) (int, error) {
+			panic("boom")
+		}), WithHooks[int](recorder.hooks())))
+		require.ErrorAs(t, err, &OperatorError{})
+		require.Len(t, recorder.panics, 1)
+		require.Equal(t, "boom", recorder.panics[0].Value)
+	})
+}
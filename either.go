@@ -32,3 +32,59 @@ func (e *either[L, R]) Left() (L, bool) {
 func (e *either[L, R]) Right() (R, bool) {
 	return e.right, e.IsRight()
 }
+
+func Right[L, R any](value R) Either[L, R] {
+	return &either[L, R]{right: value}
+}
+
+// Fold applies onLeft or onRight on the value of the Either, depending on which side it holds.
+func Fold[L, R, X any](e Either[L, R], onLeft func(L) X, onRight func(R) X) X {
+	if v, ok := e.Left(); ok {
+		return onLeft(v)
+	}
+	v, _ := e.Right()
+	return onRight(v)
+}
+
+// MapLeft transforms the left value of the Either, a right value is kept as it is.
+func MapLeft[L, R, X any](e Either[L, R], mapper func(L) X) Either[X, R] {
+	if v, ok := e.Left(); ok {
+		return Left[X, R](mapper(v))
+	}
+	v, _ := e.Right()
+	return Right[X](v)
+}
+
+// MapRight transforms the right value of the Either, a left value is kept as it is.
+func MapRight[L, R, X any](e Either[L, R], mapper func(R) X) Either[L, X] {
+	if v, ok := e.Right(); ok {
+		return Right[L](mapper(v))
+	}
+	v, _ := e.Left()
+	return Left[L, X](v)
+}
+
+// Splits an Observable of Either into two Observables, the first one emits the left values and the second one emits the right values. Both of them mirror the errors and the completion of the source, and each of them subscribes to the source separately.
+func SplitEither[L, R any](source Observable[Either[L, R]]) (Observable[L], Observable[R]) {
+	left := Pipe2(
+		source,
+		Filter(func(v Either[L, R], _ uint) bool {
+			return v.IsLeft()
+		}),
+		Map(func(v Either[L, R], _ uint) (L, error) {
+			value, _ := v.Left()
+			return value, nil
+		}),
+	)
+	right := Pipe2(
+		source,
+		Filter(func(v Either[L, R], _ uint) bool {
+			return v.IsRight()
+		}),
+		Map(func(v Either[L, R], _ uint) (R, error) {
+			value, _ := v.Right()
+			return value, nil
+		}),
+	)
+	return left, right
+}
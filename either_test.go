@@ -0,0 +1,90 @@
+package rxgo
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEither(t *testing.T) {
+	t.Run("Left", func(t *testing.T) {
+		e := Left[string, int]("a")
+		require.True(t, e.IsLeft())
+		require.False(t, e.IsRight())
+		v, ok := e.Left()
+		require.True(t, ok)
+		require.Equal(t, "a", v)
+		_, ok = e.Right()
+		require.False(t, ok)
+	})
+
+	t.Run("Right", func(t *testing.T) {
+		e := Right[string](10)
+		require.False(t, e.IsLeft())
+		require.True(t, e.IsRight())
+		v, ok := e.Right()
+		require.True(t, ok)
+		require.Equal(t, 10, v)
+		_, ok = e.Left()
+		require.False(t, ok)
+	})
+
+	t.Run("Fold", func(t *testing.T) {
+		toString := func(e Either[string, int]) string {
+			return Fold(e, func(l string) string {
+				return "left:" + l
+			}, func(r int) string {
+				return "right:" + strconv.Itoa(r)
+			})
+		}
+		require.Equal(t, "left:a", toString(Left[string, int]("a")))
+		require.Equal(t, "right:1", toString(Right[string](1)))
+	})
+
+	t.Run("MapLeft and MapRight", func(t *testing.T) {
+		length := func(v string) int {
+			return len(v)
+		}
+		double := func(v int) int {
+			return v * 2
+		}
+
+		l := MapLeft(Left[string, int]("abc"), length)
+		v, ok := l.Left()
+		require.True(t, ok)
+		require.Equal(t, 3, v)
+		require.True(t, MapLeft(Right[string](7), length).IsRight())
+
+		r := MapRight(Right[string](7), double)
+		v, ok = r.Right()
+		require.True(t, ok)
+		require.Equal(t, 14, v)
+		require.True(t, MapRight(Left[string, int]("abc"), double).IsLeft())
+	})
+}
+
+func TestSplitEither(t *testing.T) {
+	source := Of2(
+		Left[string, int]("a"),
+		Right[string](1),
+		Right[string](2),
+		Left[string, int]("b"),
+	)
+
+	t.Run("SplitEither with values", func(t *testing.T) {
+		left, right := SplitEither(source)
+		checkObservableResults(t, left, []string{"a", "b"}, nil, true)
+		checkObservableResults(t, right, []int{1, 2}, nil, true)
+	})
+
+	t.Run("SplitEither with error", func(t *testing.T) {
+		var err = errors.New("failed")
+		left, right := SplitEither(Throw[Either[string, int]](func() error {
+			return err
+		}))
+		checkObservableResults(t, left, []string{}, err, false)
+		checkObservableResults(t, right, []int{}, err, false)
+	})
+}
@@ -17,6 +17,8 @@ var (
 	ErrArgumentOutOfRange = errors.New("rxgo: argument out of range")
 	// An error thrown by the timeout operator.
 	ErrTimeout = errors.New("rxgo: timeout")
+	// An error thrown when a subscription is cancelled from a `Registry`.
+	ErrCancelled = errors.New("rxgo: subscription cancelled")
 )
 
 // Catches errors on the observable to be handled by returning a new observable or throwing an error.
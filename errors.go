@@ -1,5 +1,13 @@
 package rxgo
 
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
 // IllegalInputError is triggered when the observable receives an illegal input.
 type IllegalInputError struct {
 	error string
@@ -17,3 +25,105 @@ type IndexOutOfBoundError struct {
 func (e IndexOutOfBoundError) Error() string {
 	return "index out of bound: " + e.error
 }
+
+// UnmarshalError is triggered when the observable cannot decode an item, it carries the offending bytes.
+type UnmarshalError struct {
+	Data []byte
+	Err  error
+}
+
+func (e UnmarshalError) Error() string {
+	return "unable to unmarshal " + strconv.Quote(string(e.Data)) + ": " + e.Err.Error()
+}
+
+func (e UnmarshalError) Unwrap() error {
+	return e.Err
+}
+
+// ForkJoinError is triggered by `ForkJoinMap` when it's collecting errors, it holds the error of every failed Observable by key.
+type ForkJoinError[K comparable] struct {
+	Errors map[K]error
+}
+
+func (e ForkJoinError[K]) Error() string {
+	msgs := make([]string, 0, len(e.Errors))
+	for k, err := range e.Errors {
+		msgs = append(msgs, fmt.Sprintf("%v: %v", k, err))
+	}
+	sort.Strings(msgs)
+	return "fork join failed: " + strings.Join(msgs, "; ")
+}
+
+// Is reports whether any of the collected errors matches the target, so `errors.Is` looks into them.
+func (e ForkJoinError[K]) Is(target error) bool {
+	for _, err := range e.Errors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first collected error matching the target, so `errors.As` looks into them. The errors are visited in no particular order.
+func (e ForkJoinError[K]) As(target any) bool {
+	for _, err := range e.Errors {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Unwrap returns all the collected errors.
+func (e ForkJoinError[K]) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// PanicError is triggered when a panic is recovered while running the user code of an Observable, it carries the panic value and the stack of the panicking goroutine.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap returns the panic value if it's an error.
+func (e PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// OperatorError is emitted in debug mode, see `EnableDebug`, it locates the operator which produced the error.
+type OperatorError struct {
+	// Operator is the name of the operator, such as "Map".
+	Operator string
+	// Location is the file and line where the operator was applied.
+	Location string
+	// Stack is the stack captured when the operator was applied.
+	Stack []byte
+	// Index is the index of the item being processed when the error occurred, or the number of values emitted by the operator if it doesn't process the items one by one.
+	Index uint
+	// Value is the item being processed, it's only captured if `DebugConfig.CaptureValues` is set.
+	Value any
+	Err   error
+}
+
+func (e OperatorError) Error() string {
+	location := e.Location
+	if location == "" {
+		location = "unknown location"
+	}
+	return fmt.Sprintf("%s (%s) failed at item #%d: %v", e.Operator, location, e.Index, e.Err)
+}
+
+func (e OperatorError) Unwrap() error {
+	return e.Err
+}
@@ -1,6 +1,7 @@
 package rxgo
 
 import (
+	"context"
 	"log"
 	"reflect"
 	"sync"
@@ -343,6 +344,29 @@ func Filter[T any](predicate PredicateFunc[T]) OperatorFunc[T, T] {
 	}
 }
 
+// Same as `Filter`, but the predicate receives a context which is cancelled when the subscriber stops or the stream terminates.
+func FilterCtx[T any](predicate PredicateCtxFunc[T]) OperatorFunc[T, T] {
+	if predicate == nil {
+		panic(`rxgo: "FilterCtx" expected predicate func`)
+	}
+	return func(source Observable[T]) Observable[T] {
+		return createOperatorCtxFunc(
+			source,
+			func(ctx context.Context, obs Observer[T], v T, index uint) {
+				if predicate(ctx, v, index) {
+					obs.Next(v)
+				}
+			},
+			func(obs Observer[T], err error) {
+				obs.Error(err)
+			},
+			func(obs Observer[T]) {
+				obs.Complete()
+			},
+		)
+	}
+}
+
 // Emits only the first value (or the first value that meets some condition) emitted by the source Observable.
 func First[T any](predicate PredicateFunc[T], defaultValue ...T) OperatorFunc[T, T] {
 	return func(source Observable[T]) Observable[T] {
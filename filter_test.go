@@ -1,6 +1,7 @@
 package rxgo
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -786,3 +787,24 @@ func TestThrottleTime(t *testing.T) {
 		), []string{"("}, nil, true)
 	})
 }
+
+func TestFilterCtx(t *testing.T) {
+	t.Run("FilterCtx with Empty", func(t *testing.T) {
+		checkObservableResults(t, Pipe1(Empty[uint](), FilterCtx(func(context.Context, uint, uint) bool {
+			return true
+		})), []uint{}, nil, true)
+	})
+
+	t.Run("FilterCtx with error", func(t *testing.T) {
+		var err = errors.New("failed")
+		checkObservableResults(t, Pipe1(Scheduled[any](1, err), FilterCtx(func(context.Context, any, uint) bool {
+			return true
+		})), []any{1}, err, false)
+	})
+
+	t.Run("FilterCtx with values", func(t *testing.T) {
+		checkObservableResults(t, Pipe1(Range[uint](1, 6), FilterCtx(func(ctx context.Context, v uint, _ uint) bool {
+			return ctx.Err() == nil && v%2 == 0
+		})), []uint{2, 4, 6}, nil, true)
+	})
+}
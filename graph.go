@@ -0,0 +1,278 @@
+package rxgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	graphEnabled atomic.Bool
+	graphNodeID  atomic.Uint64
+)
+
+// EnableGraph enables the recording of the pipeline graph: every Observable and operator created afterwards registers itself as a node, linked to its inputs by the pipes and the join operators, see `ExportDOT` and `ExportJSON`. Capturing the names of the nodes has a cost, it's meant for development.
+func EnableGraph() {
+	graphEnabled.Store(true)
+}
+
+// DisableGraph disables the recording of the pipeline graph.
+func DisableGraph() {
+	graphEnabled.Store(false)
+}
+
+// The kinds of `GraphNode`.
+const (
+	GraphSource   = "source"
+	GraphOperator = "operator"
+	GraphFanIn    = "fan-in"
+	GraphFanOut   = "fan-out"
+	GraphGroup    = "group"
+)
+
+// GraphNode is an Observable of a `Graph`.
+type GraphNode struct {
+	ID       uint64 `json:"id"`
+	Name     string `json:"name"`
+	Kind     string `json:"kind"`
+	Location string `json:"location,omitempty"`
+}
+
+// GraphEdge links an Observable to the Observable consuming it.
+type GraphEdge struct {
+	From uint64 `json:"from"`
+	To   uint64 `json:"to"`
+}
+
+// Graph is the topology of a pipeline.
+type Graph struct {
+	Nodes []GraphNode `json:"nodes"`
+	Edges []GraphEdge `json:"edges"`
+}
+
+// graphNode is the node of an Observable created while the graph is enabled.
+type graphNode struct {
+	id       uint64
+	name     string
+	location string
+	group    bool
+	mu       sync.Mutex
+	inputs   []*graphNode
+	// created at runtime, such as the groups of `GroupBy`
+	children []*graphNode
+}
+
+// graphTracker is implemented by the Observables created by this package.
+type graphTracker interface {
+	graphNode() *graphNode
+}
+
+// newGraphNode returns the node of the Observable created by the caller, it
+// returns nil unless the graph is enabled.
+func newGraphNode(skip int) *graphNode {
+	if !graphEnabled.Load() {
+		return nil
+	}
+	operator, location, _ := callSite(skip+1, 32)
+	if location != "" {
+		location = filepath.Base(location)
+	}
+	return &graphNode{id: graphNodeID.Add(1), name: operator, location: location}
+}
+
+// nodeOf returns the node of the Observable, a placeholder node is created for
+// the Observables created while the graph was disabled or by another package.
+func nodeOf(obs any) *graphNode {
+	if t, ok := obs.(graphTracker); ok {
+		if node := t.graphNode(); node != nil {
+			return node
+		}
+	}
+	return &graphNode{id: graphNodeID.Add(1), name: "Observable"}
+}
+
+// linkGraph links the Observable to its inputs, it's a no-op unless the
+// Observable has been created while the graph was enabled.
+func linkGraph[T any, S any](obs Observable[T], inputs ...Observable[S]) Observable[T] {
+	t, ok := obs.(graphTracker)
+	if !ok || t.graphNode() == nil {
+		return obs
+	}
+
+	node := t.graphNode()
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	for _, input := range inputs {
+		node.link(nodeOf(input))
+	}
+	return obs
+}
+
+// relinkGraph replaces the inputs of the Observable, it's used by the
+// operators built on top of others to hide their internal nodes.
+func relinkGraph[T any, S any](obs Observable[T], inputs ...Observable[S]) Observable[T] {
+	if t, ok := obs.(graphTracker); ok && t.graphNode() != nil {
+		node := t.graphNode()
+		node.mu.Lock()
+		node.inputs = nil
+		node.mu.Unlock()
+	}
+	return linkGraph(obs, inputs...)
+}
+
+// relinkGraphAny is the same as `relinkGraph`, for inputs of different types.
+func relinkGraphAny[T any](obs Observable[T], inputs ...any) Observable[T] {
+	t, ok := obs.(graphTracker)
+	if !ok || t.graphNode() == nil {
+		return obs
+	}
+
+	node := t.graphNode()
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	node.inputs = nil
+	for _, input := range inputs {
+		node.link(nodeOf(input))
+	}
+	return obs
+}
+
+// link adds the input to the node, the lock of the node must be held.
+func (n *graphNode) link(in *graphNode) {
+	if in != n && !containsNode(n.inputs, in) {
+		n.inputs = append(n.inputs, in)
+	}
+}
+
+// addGraphChild records an Observable created at runtime by the parent.
+func addGraphChild(parent any, name string) {
+	t, ok := parent.(graphTracker)
+	if !ok || t.graphNode() == nil {
+		return
+	}
+
+	node := t.graphNode()
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	for _, child := range node.children {
+		if child.name == name {
+			return
+		}
+	}
+	node.children = append(node.children, &graphNode{
+		id:     graphNodeID.Add(1),
+		name:   name,
+		group:  true,
+		inputs: []*graphNode{node},
+	})
+}
+
+func containsNode(nodes []*graphNode, node *graphNode) bool {
+	for _, n := range nodes {
+		if n == node {
+			return true
+		}
+	}
+	return false
+}
+
+// GraphOf returns the graph of the pipeline ending with the Observable: every Observable it consumes, directly or not, along with the Observables created at runtime by them. Only the Observables created while the graph is enabled know their inputs.
+func GraphOf[T any](obs Observable[T]) Graph {
+	var (
+		graph   = Graph{Nodes: []GraphNode{}, Edges: []GraphEdge{}}
+		visited = make(map[*graphNode]bool)
+		visit   func(node *graphNode)
+	)
+
+	visit = func(node *graphNode) {
+		if visited[node] {
+			return
+		}
+		visited[node] = true
+
+		node.mu.Lock()
+		inputs := append([]*graphNode(nil), node.inputs...)
+		children := append([]*graphNode(nil), node.children...)
+		node.mu.Unlock()
+
+		kind := GraphOperator
+		switch {
+		case node.group:
+			kind = GraphGroup
+		case len(children) > 0:
+			kind = GraphFanOut
+		case len(inputs) == 0:
+			kind = GraphSource
+		case len(inputs) > 1:
+			kind = GraphFanIn
+		}
+		graph.Nodes = append(graph.Nodes, GraphNode{
+			ID:       node.id,
+			Name:     node.name,
+			Kind:     kind,
+			Location: node.location,
+		})
+
+		for _, in := range inputs {
+			graph.Edges = append(graph.Edges, GraphEdge{From: in.id, To: node.id})
+			visit(in)
+		}
+		for _, child := range children {
+			visit(child)
+		}
+	}
+	visit(nodeOf(obs))
+
+	sort.Slice(graph.Nodes, func(i, j int) bool {
+		return graph.Nodes[i].ID < graph.Nodes[j].ID
+	})
+	sort.Slice(graph.Edges, func(i, j int) bool {
+		if graph.Edges[i].From != graph.Edges[j].From {
+			return graph.Edges[i].From < graph.Edges[j].From
+		}
+		return graph.Edges[i].To < graph.Edges[j].To
+	})
+	return graph
+}
+
+// ExportJSON renders the graph of the pipeline ending with the Observable as JSON, see `GraphOf`.
+func ExportJSON[T any](obs Observable[T]) ([]byte, error) {
+	return json.Marshal(GraphOf(obs))
+}
+
+// ExportDOT renders the graph of the pipeline ending with the Observable in the Graphviz DOT language, see `GraphOf`.
+func ExportDOT[T any](obs Observable[T]) string {
+	graph := GraphOf(obs)
+
+	var b strings.Builder
+	b.WriteString("digraph pipeline {\n\trankdir=LR;\n")
+	for _, node := range graph.Nodes {
+		label := node.Name
+		if node.Location != "" {
+			label += "\n" + node.Location
+		}
+		fmt.Fprintf(&b, "\tn%d [label=%q shape=%s];\n", node.ID, label, dotShape(node.Kind))
+	}
+	for _, edge := range graph.Edges {
+		fmt.Fprintf(&b, "\tn%d -> n%d;\n", edge.From, edge.To)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func dotShape(kind string) string {
+	switch kind {
+	case GraphSource:
+		return "ellipse"
+	case GraphFanIn, GraphFanOut:
+		return "diamond"
+	case GraphGroup:
+		return "folder"
+	default:
+		return "box"
+	}
+}
@@ -0,0 +1,208 @@
+package rxgo
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// graphNodes returns the nodes of the graph by name.
+func graphNodes(graph Graph) map[string][]GraphNode {
+	nodes := make(map[string][]GraphNode)
+	for _, node := range graph.Nodes {
+		nodes[node.Name] = append(nodes[node.Name], node)
+	}
+	return nodes
+}
+
+func hasEdge(graph Graph, from, to GraphNode) bool {
+	for _, edge := range graph.Edges {
+		if edge.From == from.ID && edge.To == to.ID {
+			return true
+		}
+	}
+	return false
+}
+
+func TestGraph(t *testing.T) {
+	t.Run("GraphOf with the graph disabled", func(t *testing.T) {
+		graph := GraphOf(Pipe1(Of2(1), Map(func(v int, _ uint) (int, error) {
+			return v, nil
+		})))
+		require.Len(t, graph.Nodes, 1)
+		require.Equal(t, "Observable", graph.Nodes[0].Name)
+		require.Equal(t, GraphSource, graph.Nodes[0].Kind)
+		require.Empty(t, graph.Edges)
+	})
+
+	t.Run("GraphOf with a chain", func(t *testing.T) {
+		EnableGraph()
+		t.Cleanup(DisableGraph)
+
+		graph := GraphOf(Pipe2(
+			Range[uint](1, 5),
+			Map(func(v uint, _ uint) (uint, error) {
+				return v, nil
+			}),
+			Filter(func(v uint, _ uint) bool {
+				return true
+			}),
+		))
+
+		nodes := graphNodes(graph)
+		require.Len(t, graph.Nodes, 3)
+		require.Len(t, graph.Edges, 2)
+		require.Equal(t, GraphSource, nodes["Range"][0].Kind)
+		require.Equal(t, GraphOperator, nodes["Map"][0].Kind)
+		require.Equal(t, GraphOperator, nodes["Filter"][0].Kind)
+		require.True(t, strings.HasPrefix(nodes["Map"][0].Location, "graph_test.go:"))
+		require.True(t, hasEdge(graph, nodes["Range"][0], nodes["Map"][0]))
+		require.True(t, hasEdge(graph, nodes["Map"][0], nodes["Filter"][0]))
+	})
+
+	t.Run("GraphOf with fan-in", func(t *testing.T) {
+		EnableGraph()
+		t.Cleanup(DisableGraph)
+
+		var (
+			a = Of2[uint](1, 2)
+			b = Range[uint](10, 2)
+			c = Interval(1)
+		)
+		for name, obs := range map[string]Observable[uint]{
+			"Merge":     Merge(a, b),
+			"Concat":    Pipe1(a, ConcatWith(b)),
+			"MergeWith": Pipe1(a, MergeWith(b)),
+		} {
+			graph := GraphOf(obs)
+			nodes := graphNodes(graph)
+			require.Len(t, graph.Nodes, 3, name)
+			require.Len(t, graph.Edges, 2, name)
+
+			out := graph.Nodes[len(graph.Nodes)-1]
+			require.Equal(t, GraphFanIn, out.Kind, name)
+			require.True(t, hasEdge(graph, nodes["Of2"][0], out), name)
+			require.True(t, hasEdge(graph, nodes["Range"][0], out), name)
+		}
+
+		graph := GraphOf(Zip(Pipe1(c, Map(func(v uint, _ uint) (uint, error) {
+			return v * 2, nil
+		})), a))
+		nodes := graphNodes(graph)
+		require.Equal(t, GraphFanIn, nodes["Zip"][0].Kind)
+		require.True(t, hasEdge(graph, nodes["Map"][0], nodes["Zip"][0]))
+		require.True(t, hasEdge(graph, nodes["Of2"][0], nodes["Zip"][0]))
+		require.True(t, hasEdge(graph, nodes["Interval"][0], nodes["Map"][0]))
+	})
+
+	t.Run("GraphOf with typed fan-in", func(t *testing.T) {
+		EnableGraph()
+		t.Cleanup(DisableGraph)
+
+		var (
+			a = Of2(1, 2)
+			b = Of2("a", "b")
+		)
+		for name, obs := range map[string]Observable[Tuple[int, string]]{
+			"Zip2":           Zip2(a, b),
+			"CombineLatest2": CombineLatest2(a, b),
+			"ForkJoin2":      ForkJoin2(a, b),
+		} {
+			graph := GraphOf(obs)
+			nodes := graphNodes(graph)
+			require.Len(t, graph.Nodes, 3, name)
+			require.Len(t, graph.Edges, 2, name)
+			require.Len(t, nodes[name], 1, name)
+			require.Equal(t, GraphFanIn, nodes[name][0].Kind, name)
+			require.True(t, hasEdge(graph, nodes["Of2"][0], nodes[name][0]), name)
+			require.True(t, hasEdge(graph, nodes["Of2"][1], nodes[name][0]), name)
+		}
+
+		graph := GraphOf(ForkJoinMap(map[string]Observable[int]{
+			"a": a,
+			"b": Pipe1(a, Map(func(v int, _ uint) (int, error) {
+				return v * 2, nil
+			})),
+		}))
+		nodes := graphNodes(graph)
+		require.Len(t, graph.Nodes, 3)
+		require.Len(t, graph.Edges, 3)
+		require.Equal(t, GraphFanIn, nodes["ForkJoinMap"][0].Kind)
+		require.True(t, hasEdge(graph, nodes["Of2"][0], nodes["ForkJoinMap"][0]))
+		require.True(t, hasEdge(graph, nodes["Map"][0], nodes["ForkJoinMap"][0]))
+		require.True(t, hasEdge(graph, nodes["Of2"][0], nodes["Map"][0]))
+	})
+
+	t.Run("GraphOf with a shared source", func(t *testing.T) {
+		EnableGraph()
+		t.Cleanup(DisableGraph)
+
+		source := Of2(1, 2, 3)
+		graph := GraphOf(CombineLatest(
+			Pipe1(source, Filter(func(v int, _ uint) bool {
+				return v%2 == 0
+			})),
+			Pipe1(source, Filter(func(v int, _ uint) bool {
+				return v%2 != 0
+			})),
+		))
+
+		nodes := graphNodes(graph)
+		require.Len(t, nodes["Of2"], 1)
+		require.Len(t, nodes["Filter"], 2)
+		require.Len(t, graph.Edges, 4)
+	})
+
+	t.Run("GraphOf with groups", func(t *testing.T) {
+		EnableGraph()
+		t.Cleanup(DisableGraph)
+
+		obs := Pipe1(Of2(1, 2, 3), GroupBy(func(v int) bool {
+			return v%2 == 0
+		}))
+		obs.SubscribeSync(nil, nil, nil)
+
+		graph := GraphOf(obs)
+		nodes := graphNodes(graph)
+		require.Equal(t, GraphFanOut, nodes["GroupBy"][0].Kind)
+		for _, name := range []string{"Group(true)", "Group(false)"} {
+			require.Len(t, nodes[name], 1)
+			require.Equal(t, GraphGroup, nodes[name][0].Kind)
+			require.True(t, hasEdge(graph, nodes["GroupBy"][0], nodes[name][0]))
+		}
+
+		// resubscribing doesn't duplicate the groups
+		obs.SubscribeSync(nil, nil, nil)
+		require.Len(t, GraphOf(obs).Nodes, len(graph.Nodes))
+	})
+
+	t.Run("ExportDOT", func(t *testing.T) {
+		EnableGraph()
+		t.Cleanup(DisableGraph)
+
+		dot := ExportDOT(Pipe1(Of2("a"), Map(func(v string, _ uint) (string, error) {
+			return v, nil
+		})))
+		require.True(t, strings.HasPrefix(dot, "digraph pipeline {\n\trankdir=LR;\n"))
+		require.True(t, strings.HasSuffix(dot, "}\n"))
+		require.Regexp(t, `\tn\d+ \[label="Of2\\ngraph_test.go:\d+" shape=ellipse\];`, dot)
+		require.Regexp(t, `\tn\d+ \[label="Map\\ngraph_test.go:\d+" shape=box\];`, dot)
+		require.Regexp(t, `\tn\d+ -> n\d+;`, dot)
+	})
+
+	t.Run("ExportJSON", func(t *testing.T) {
+		EnableGraph()
+		t.Cleanup(DisableGraph)
+
+		b, err := ExportJSON(Merge(Of2(1), Of2(2)))
+		require.NoError(t, err)
+
+		var graph Graph
+		require.NoError(t, json.Unmarshal(b, &graph))
+		require.Len(t, graph.Nodes, 3)
+		require.Len(t, graph.Edges, 2)
+		require.Contains(t, string(b), `"kind":"fan-in"`)
+	})
+}
@@ -0,0 +1,123 @@
+package rxgo
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+)
+
+// Hooks are callbacks called by RxGo on events which can't be observed from the streams, such as errors which can't be delivered. They are useful to log and alert in a single place. Every callback is optional and must be safe for concurrent use.
+type Hooks struct {
+	// OnUndeliverableError is called with the errors which can't be delivered, because the subscriber has already stopped or terminated, or because another error has already been delivered.
+	OnUndeliverableError func(err error)
+	// OnPanic is called when a panic is recovered and converted into an error.
+	OnPanic func(err PanicError)
+	// OnSubscribe is called whenever an Observable is subscribed to.
+	OnSubscribe func(observable any)
+	// OnAssembly is called whenever an Observable is created.
+	OnAssembly func(observable any)
+}
+
+var globalHooks atomic.Pointer[Hooks]
+
+// SetHooks replaces the global hooks, they apply to every stream.
+func SetHooks(hooks Hooks) {
+	globalHooks.Store(&hooks)
+}
+
+// ResetHooks removes the global hooks.
+func ResetHooks() {
+	globalHooks.Store(nil)
+}
+
+// GetHooks returns the global hooks.
+func GetHooks() Hooks {
+	if hooks := globalHooks.Load(); hooks != nil {
+		return *hooks
+	}
+	return Hooks{}
+}
+
+// WithHooks scopes the hooks to the subscriptions of the returned Observable, in addition to the global hooks. The errors and panics are only observed when they go through the returned Observable: a `PanicError` emitted by the source is reported to `OnPanic`, and an error which can't be delivered to the subscriber is reported to `OnUndeliverableError`.
+func WithHooks[T any](hooks Hooks) OperatorFunc[T, T] {
+	return func(source Observable[T]) Observable[T] {
+		obs := newObservable(func(subscriber Subscriber[T]) {
+			if hooks.OnSubscribe != nil {
+				hooks.OnSubscribe(source)
+			}
+
+			var (
+				wg = new(sync.WaitGroup)
+			)
+
+			wg.Add(1)
+
+			var (
+				upStream = source.SubscribeOn(wg.Done)
+			)
+
+		loop:
+			for {
+				select {
+				case <-subscriber.Closed():
+					upStream.Stop()
+					break loop
+
+				case item, ok := <-upStream.ForEach():
+					if !ok {
+						break loop
+					}
+
+					err := item.Err()
+					// the panic may be wrapped, such as into an `OperatorError` in debug mode
+					var panicErr PanicError
+					if err != nil && hooks.OnPanic != nil && errors.As(err, &panicErr) {
+						hooks.OnPanic(panicErr)
+					}
+
+					if !item.Send(subscriber) {
+						if err != nil && hooks.OnUndeliverableError != nil {
+							hooks.OnUndeliverableError(err)
+						}
+						upStream.Stop()
+						break loop
+					}
+
+					if item.IsEnd() {
+						break loop
+					}
+				}
+			}
+
+			wg.Wait()
+		})
+		if hooks.OnAssembly != nil {
+			hooks.OnAssembly(obs)
+		}
+		return obs
+	}
+}
+
+func onUndeliverableError(err error) {
+	if hooks := globalHooks.Load(); hooks != nil && hooks.OnUndeliverableError != nil {
+		hooks.OnUndeliverableError(err)
+	}
+}
+
+func onPanic(err PanicError) {
+	if hooks := globalHooks.Load(); hooks != nil && hooks.OnPanic != nil {
+		hooks.OnPanic(err)
+	}
+}
+
+func onSubscribe(observable any) {
+	if hooks := globalHooks.Load(); hooks != nil && hooks.OnSubscribe != nil {
+		hooks.OnSubscribe(observable)
+	}
+}
+
+func onAssembly(observable any) {
+	if hooks := globalHooks.Load(); hooks != nil && hooks.OnAssembly != nil {
+		hooks.OnAssembly(observable)
+	}
+}
@@ -0,0 +1,150 @@
+package rxgo
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type hooksRecorder struct {
+	mu            sync.Mutex
+	undeliverable []error
+	panics        []PanicError
+	subscribed    int32
+	assembled     int32
+}
+
+func (r *hooksRecorder) hooks() Hooks {
+	return Hooks{
+		OnUndeliverableError: func(err error) {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			r.undeliverable = append(r.undeliverable, err)
+		},
+		OnPanic: func(err PanicError) {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			r.panics = append(r.panics, err)
+		},
+		OnSubscribe: func(any) {
+			atomic.AddInt32(&r.subscribed, 1)
+		},
+		OnAssembly: func(any) {
+			atomic.AddInt32(&r.assembled, 1)
+		},
+	}
+}
+
+func (r *hooksRecorder) undeliverableErrors() []error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]error(nil), r.undeliverable...)
+}
+
+func TestHooks(t *testing.T) {
+	t.Run("SetHooks and ResetHooks", func(t *testing.T) {
+		t.Cleanup(ResetHooks)
+		require.Nil(t, GetHooks().OnPanic)
+		SetHooks(Hooks{OnPanic: func(PanicError) {}})
+		require.NotNil(t, GetHooks().OnPanic)
+		ResetHooks()
+		require.Nil(t, GetHooks().OnPanic)
+	})
+
+	t.Run("OnUndeliverableError with concurrent errors", func(t *testing.T) {
+		t.Cleanup(ResetHooks)
+		var (
+			recorder = new(hooksRecorder)
+			err1     = errors.New("first")
+			err2     = errors.New("second")
+		)
+		SetHooks(recorder.hooks())
+
+		var err error
+		Pipe1(Of2(err1, err2), MergeMap(func(e error, _ uint) Observable[any] {
+			return newObservable(func(subscriber Subscriber[any]) {
+				if e == err2 {
+					// ignores the cancellation, and fails once the first error has stopped it
+					<-subscriber.Closed()
+				}
+				Error[any](e).Send(subscriber)
+			})
+		})).SubscribeSync(nil, func(e error) {
+			err = e
+		}, nil)
+
+		require.Equal(t, err1, err)
+		require.Eventually(t, func() bool {
+			return len(recorder.undeliverableErrors()) == 1
+		}, time.Second, time.Millisecond)
+		require.Equal(t, []error{err2}, recorder.undeliverableErrors())
+	})
+
+	t.Run("OnUndeliverableError after downstream stopped", func(t *testing.T) {
+		t.Cleanup(ResetHooks)
+		var (
+			recorder = new(hooksRecorder)
+			err      = errors.New("failed")
+		)
+		SetHooks(recorder.hooks())
+
+		sub := NewSubscriber[int]()
+		sub.Stop()
+		require.False(t, Error[int](err).Send(sub))
+		require.False(t, Next(1).Send(sub))
+		require.Equal(t, []error{err}, recorder.undeliverableErrors())
+	})
+
+	t.Run("OnPanic", func(t *testing.T) {
+		t.Cleanup(ResetHooks)
+		recorder := new(hooksRecorder)
+		SetHooks(recorder.hooks())
+
+		var err error
+		Pipe1(Of2(1), Map(func(int, uint) (int, error) {
+			panic("boom")
+		})).SubscribeSync(nil, func(e error) {
+			err = e
+		}, nil)
+
+		require.ErrorAs(t, err, &PanicError{})
+		recorder.mu.Lock()
+		defer recorder.mu.Unlock()
+		require.Len(t, recorder.panics, 1)
+		require.Equal(t, "boom", recorder.panics[0].Value)
+	})
+
+	t.Run("OnSubscribe and OnAssembly", func(t *testing.T) {
+		t.Cleanup(ResetHooks)
+		recorder := new(hooksRecorder)
+		SetHooks(recorder.hooks())
+
+		obs := Pipe1(Of2(1), Map(func(v int, _ uint) (int, error) {
+			return v, nil
+		}))
+		require.Equal(t, int32(2), atomic.LoadInt32(&recorder.assembled))
+
+		checkObservableResults(t, obs, []int{1}, nil, true)
+		require.Equal(t, int32(2), atomic.LoadInt32(&recorder.subscribed))
+	})
+
+	t.Run("WithHooks", func(t *testing.T) {
+		recorder := new(hooksRecorder)
+		var err error
+		Pipe2(Of2(1), Map(func(int, uint) (int, error) {
+			panic("boom")
+		}), WithHooks[int](recorder.hooks())).SubscribeSync(nil, func(e error) {
+			err = e
+		}, nil)
+
+		require.ErrorAs(t, err, &PanicError{})
+		require.Len(t, recorder.panics, 1)
+		require.Equal(t, "boom", recorder.panics[0].Value)
+		require.Equal(t, int32(1), atomic.LoadInt32(&recorder.subscribed))
+		require.Equal(t, int32(1), atomic.LoadInt32(&recorder.assembled))
+	})
+}
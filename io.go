@@ -0,0 +1,301 @@
+package rxgo
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"sync"
+	"time"
+)
+
+// An error thrown when a length-prefixed frame declares a size bigger than the reader allows.
+var ErrFrameTooLarge = errors.New("rxgo: frame too large")
+
+// Creates an Observable that reads from the given reader and emits every token produced by the split function. If split is nil, the reader will be split by lines.
+func FromReader(r io.Reader, split bufio.SplitFunc, maxTokenSize ...uint) Observable[[]byte] {
+	if r == nil {
+		panic(`rxgo: "FromReader" expected reader`)
+	}
+	if split == nil {
+		split = bufio.ScanLines
+	}
+	return newObservable(func(subscriber Subscriber[[]byte]) {
+		var (
+			wg      = new(sync.WaitGroup)
+			doneCh  = make(chan struct{})
+			scanner = bufio.NewScanner(r)
+			stopped bool
+			closed  bool
+		)
+
+		scanner.Split(split)
+		if len(maxTokenSize) > 0 && maxTokenSize[0] > 0 {
+			// the initial capacity of the buffer must not exceed the maximum,
+			// otherwise the scanner is allowed to grow up to the capacity
+			var (
+				size    = int(maxTokenSize[0])
+				initial = 4096
+			)
+			if size < initial {
+				initial = size
+			}
+			scanner.Buffer(make([]byte, 0, initial), size)
+		}
+
+		wg.Add(1)
+
+		// when the subscriber stop listening, the only way to release a blocking
+		// `Read` is closing the reader (if the reader is closable)
+		go func() {
+			defer wg.Done()
+			select {
+			case <-subscriber.Closed():
+				if closer, ok := r.(io.Closer); ok {
+					closed = true
+					closer.Close()
+				}
+			case <-doneCh:
+			}
+		}()
+
+		for scanner.Scan() {
+			// the underlying array of the token may be overwritten by the next scan
+			token := make([]byte, len(scanner.Bytes()))
+			copy(token, scanner.Bytes())
+			if !Next(token).Send(subscriber) {
+				stopped = true
+				break
+			}
+		}
+
+		close(doneCh)
+		wg.Wait()
+
+		// the error caused by closing the reader isn't a failure of the stream
+		if stopped || closed {
+			return
+		}
+
+		if err := scanner.Err(); err != nil {
+			Error[[]byte](err).Send(subscriber)
+			return
+		}
+
+		Complete[[]byte]().Send(subscriber)
+	})
+}
+
+// ScanDelimited returns a split function that splits the input by the given delimiter. The delimiter is not part of the emitted tokens.
+func ScanDelimited(delimiter []byte) bufio.SplitFunc {
+	if len(delimiter) == 0 {
+		panic(`rxgo: "ScanDelimited" expected non-empty delimiter`)
+	}
+	return func(data []byte, atEOF bool) (int, []byte, error) {
+		if atEOF && len(data) == 0 {
+			return 0, nil, nil
+		}
+		if i := bytes.Index(data, delimiter); i >= 0 {
+			return i + len(delimiter), data[:i], nil
+		}
+		// If we're at EOF, we have a final, non-terminated token. Return it.
+		if atEOF {
+			return len(data), data, nil
+		}
+		// Request more data.
+		return 0, nil, nil
+	}
+}
+
+// ScanLengthPrefixed returns a split function for frames that start with an unsigned length header of prefixSize bytes (1, 2, 4 or 8) encoded using the given byte order. Only the payload of the frame is emitted.
+func ScanLengthPrefixed(prefixSize uint, order binary.ByteOrder) bufio.SplitFunc {
+	switch prefixSize {
+	case 1, 2, 4, 8:
+	default:
+		panic(`rxgo: "ScanLengthPrefixed" expected prefix size of 1, 2, 4 or 8`)
+	}
+	if order == nil {
+		order = binary.BigEndian
+	}
+	headerSize := int(prefixSize)
+	return func(data []byte, atEOF bool) (int, []byte, error) {
+		if atEOF && len(data) == 0 {
+			return 0, nil, nil
+		}
+
+		if len(data) < headerSize {
+			if atEOF {
+				return 0, nil, io.ErrUnexpectedEOF
+			}
+			return 0, nil, nil
+		}
+
+		var size uint64
+		switch prefixSize {
+		case 1:
+			size = uint64(data[0])
+		case 2:
+			size = uint64(order.Uint16(data))
+		case 4:
+			size = uint64(order.Uint32(data))
+		case 8:
+			size = order.Uint64(data)
+		}
+
+		// the scanner would report `bufio.ErrTooLong` eventually, but we should
+		// never try to allocate a frame that cannot be addressed at all
+		if size > math.MaxInt32 {
+			return 0, nil, ErrFrameTooLarge
+		}
+
+		frameSize := headerSize + int(size)
+		if len(data) < frameSize {
+			if atEOF {
+				return 0, nil, io.ErrUnexpectedEOF
+			}
+			return 0, nil, nil
+		}
+
+		return frameSize, data[headerSize:frameSize], nil
+	}
+}
+
+type WriterConfig struct {
+	// The number of encoded bytes to accumulate before flushing them to the writer.
+	// If it's zero, every item will be flushed immediately.
+	BufferSize uint
+	// The maximum time a buffered item may wait before it is flushed to the writer.
+	// If it's zero, the buffer will only be flushed based on its size.
+	FlushInterval time.Duration
+}
+
+// ToWriter encodes every value from the source Observable and writes it to the given writer. Writes are buffered according to the optional config, and the number of bytes flushed is emitted after each flush. If the writer has a `Flush() error` method (such as `*bufio.Writer`), it will be called after every flush as well.
+func ToWriter[T any](w io.Writer, encoder func(T) ([]byte, error), config ...WriterConfig) OperatorFunc[T, int] {
+	if w == nil {
+		panic(`rxgo: "ToWriter" expected writer`)
+	}
+	if encoder == nil {
+		panic(`rxgo: "ToWriter" expected encoder func`)
+	}
+	var (
+		cfg WriterConfig
+	)
+	if len(config) > 0 {
+		cfg = config[0]
+	}
+	return func(source Observable[T]) Observable[int] {
+		return newObservable(func(subscriber Subscriber[int]) {
+			var (
+				wg = new(sync.WaitGroup)
+			)
+
+			wg.Add(1)
+
+			var (
+				buffer   = new(bytes.Buffer)
+				upStream = source.SubscribeOn(wg.Done)
+				timer    *time.Timer
+				timerCh  <-chan time.Time
+			)
+
+			stopTimer := func() {
+				if timer != nil {
+					timer.Stop()
+				}
+				timer, timerCh = nil, nil
+			}
+
+			flush := func() error {
+				stopTimer()
+				if buffer.Len() == 0 {
+					return nil
+				}
+
+				n, err := w.Write(buffer.Bytes())
+				buffer.Reset()
+				if err != nil {
+					return err
+				}
+
+				if flusher, ok := w.(interface{ Flush() error }); ok {
+					if err := flusher.Flush(); err != nil {
+						return err
+					}
+				}
+
+				Next(n).Send(subscriber)
+				return nil
+			}
+
+			onError := func(err error) {
+				stopTimer()
+				upStream.Stop()
+				Error[int](err).Send(subscriber)
+			}
+
+		observe:
+			for {
+				select {
+				case <-subscriber.Closed():
+					stopTimer()
+					upStream.Stop()
+					break observe
+
+				case <-timerCh:
+					if err := flush(); err != nil {
+						onError(err)
+						break observe
+					}
+
+				case item, ok := <-upStream.ForEach():
+					if !ok {
+						break observe
+					}
+
+					if err := item.Err(); err != nil {
+						// flush whatever we have before propagating the error
+						if err := flush(); err != nil {
+							onError(err)
+							break observe
+						}
+						Error[int](err).Send(subscriber)
+						break observe
+					}
+
+					if item.Done() {
+						if err := flush(); err != nil {
+							onError(err)
+							break observe
+						}
+						Complete[int]().Send(subscriber)
+						break observe
+					}
+
+					b, err := encoder(item.Value())
+					if err != nil {
+						onError(err)
+						break observe
+					}
+
+					buffer.Write(b)
+					if uint(buffer.Len()) >= cfg.BufferSize {
+						if err := flush(); err != nil {
+							onError(err)
+							break observe
+						}
+						continue
+					}
+
+					if cfg.FlushInterval > 0 && timer == nil {
+						timer = time.NewTimer(cfg.FlushInterval)
+						timerCh = timer.C
+					}
+				}
+			}
+
+			wg.Wait()
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package rxgo
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type failingWriter struct {
+	err error
+}
+
+func (w failingWriter) Write(p []byte) (int, error) {
+	return 0, w.err
+}
+
+func TestFromReader(t *testing.T) {
+	t.Run("FromReader with empty reader", func(t *testing.T) {
+		checkObservableResults(t, FromReader(strings.NewReader(""), nil), [][]byte{}, nil, true)
+	})
+
+	t.Run("FromReader with lines", func(t *testing.T) {
+		checkObservableResults(t, FromReader(
+			strings.NewReader("a\nbc\r\ndef"),
+			nil,
+		), [][]byte{[]byte("a"), []byte("bc"), []byte("def")}, nil, true)
+	})
+
+	t.Run("FromReader with custom split func", func(t *testing.T) {
+		checkObservableResults(t, FromReader(
+			strings.NewReader("hello world rxgo"),
+			bufio.ScanWords,
+		), [][]byte{[]byte("hello"), []byte("world"), []byte("rxgo")}, nil, true)
+	})
+
+	t.Run("FromReader with ScanDelimited", func(t *testing.T) {
+		checkObservableResults(t, FromReader(
+			strings.NewReader("a||b||||c"),
+			ScanDelimited([]byte("||")),
+		), [][]byte{[]byte("a"), []byte("b"), {}, []byte("c")}, nil, true)
+	})
+
+	t.Run("FromReader with ScanLengthPrefixed", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		for _, v := range []string{"rx", "", "go!"} {
+			binary.Write(buf, binary.LittleEndian, uint16(len(v)))
+			buf.WriteString(v)
+		}
+		checkObservableResults(t, FromReader(
+			buf,
+			ScanLengthPrefixed(2, binary.LittleEndian),
+		), [][]byte{[]byte("rx"), {}, []byte("go!")}, nil, true)
+	})
+
+	t.Run("FromReader with truncated frame", func(t *testing.T) {
+		checkObservableResults(t, FromReader(
+			bytes.NewReader([]byte{3, 'a', 'b', 'c', 4, 'd'}),
+			ScanLengthPrefixed(1, nil),
+		), [][]byte{[]byte("abc")}, io.ErrUnexpectedEOF, false)
+	})
+
+	t.Run("FromReader with token too long", func(t *testing.T) {
+		checkObservableResults(t, FromReader(
+			strings.NewReader(strings.Repeat("a", 100)+"\nb"),
+			nil,
+			10,
+		), [][]byte{}, bufio.ErrTooLong, false)
+	})
+
+	t.Run("FromReader with reader error", func(t *testing.T) {
+		var err = errors.New("broken reader")
+		r, w := io.Pipe()
+		go func() {
+			w.Write([]byte("a\nb\n"))
+			w.CloseWithError(err)
+		}()
+		checkObservableResults(t, FromReader(r, nil), [][]byte{[]byte("a"), []byte("b")}, err, false)
+	})
+
+	t.Run("FromReader should close the reader when unsubscribed", func(t *testing.T) {
+		r, w := io.Pipe()
+		go func() {
+			for i := 0; ; i++ {
+				if _, err := fmt.Fprintf(w, "%d\n", i); err != nil {
+					return
+				}
+			}
+		}()
+		checkObservableResults(t, Pipe1(
+			FromReader(r, nil),
+			Take[[]byte](2),
+		), [][]byte{[]byte("0"), []byte("1")}, nil, true)
+
+		require.Eventually(t, func() bool {
+			_, err := w.Write([]byte("x"))
+			return errors.Is(err, io.ErrClosedPipe)
+		}, time.Second, time.Millisecond)
+	})
+
+	t.Run("FromReader should not report the closed reader error", func(t *testing.T) {
+		var undeliverable = make(chan error, 1)
+		SetHooks(Hooks{OnUndeliverableError: func(err error) {
+			undeliverable <- err
+		}})
+		defer ResetHooks()
+
+		r, w := io.Pipe()
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+		defer cancel()
+
+		_, err := FirstValue(ctx, FromReader(r, nil))
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		require.Eventually(t, func() bool {
+			_, err := w.Write([]byte("x"))
+			return errors.Is(err, io.ErrClosedPipe)
+		}, time.Second, time.Millisecond)
+
+		select {
+		case err := <-undeliverable:
+			require.FailNow(t, "unexpected undeliverable error", err)
+		case <-time.After(time.Millisecond * 50):
+		}
+	})
+}
+
+func TestToWriter(t *testing.T) {
+	lineEncoder := func(v string) ([]byte, error) {
+		return []byte(v + "\n"), nil
+	}
+
+	t.Run("ToWriter with Empty", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		checkObservableResults(t, Pipe1(
+			Empty[string](),
+			ToWriter(buf, lineEncoder),
+		), []int{}, nil, true)
+		require.Empty(t, buf.String())
+	})
+
+	t.Run("ToWriter with error", func(t *testing.T) {
+		var err = errors.New("failed")
+		buf := new(bytes.Buffer)
+		checkObservableResults(t, Pipe1(
+			Scheduled[any]("a", err),
+			ToWriter(buf, func(v any) ([]byte, error) {
+				return []byte(fmt.Sprintf("%v", v)), nil
+			}, WriterConfig{BufferSize: 100}),
+		), []int{1}, err, false)
+		require.Equal(t, "a", buf.String())
+	})
+
+	t.Run("ToWriter without buffer", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		checkObservableResults(t, Pipe1(
+			Of2("a", "bb", "ccc"),
+			ToWriter(buf, lineEncoder),
+		), []int{2, 3, 4}, nil, true)
+		require.Equal(t, "a\nbb\nccc\n", buf.String())
+	})
+
+	t.Run("ToWriter with buffer size", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		checkObservableResults(t, Pipe1(
+			Of2("a", "b", "c", "d", "e"),
+			ToWriter(buf, lineEncoder, WriterConfig{BufferSize: 4}),
+		), []int{4, 4, 2}, nil, true)
+		require.Equal(t, "a\nb\nc\nd\ne\n", buf.String())
+	})
+
+	t.Run("ToWriter with flush interval", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		checkObservableResults(t, Pipe2(
+			Interval(time.Millisecond*20),
+			Take[uint](3),
+			ToWriter(buf, func(v uint) ([]byte, error) {
+				return []byte(fmt.Sprintf("%d", v)), nil
+			}, WriterConfig{BufferSize: 1024, FlushInterval: time.Millisecond * 5}),
+		), []int{1, 1, 1}, nil, true)
+		require.Equal(t, "012", buf.String())
+	})
+
+	t.Run("ToWriter with bufio.Writer", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		checkObservableResults(t, Pipe1(
+			Of2("a", "b"),
+			ToWriter(bufio.NewWriter(buf), lineEncoder),
+		), []int{2, 2}, nil, true)
+		require.Equal(t, "a\nb\n", buf.String())
+	})
+
+	t.Run("ToWriter with encoder error", func(t *testing.T) {
+		var err = errors.New("cannot encode")
+		buf := new(bytes.Buffer)
+		checkObservableResults(t, Pipe1(
+			Range[uint](1, 5),
+			ToWriter(buf, func(v uint) ([]byte, error) {
+				if v > 2 {
+					return nil, err
+				}
+				return []byte{'x'}, nil
+			}),
+		), []int{1, 1}, err, false)
+	})
+
+	t.Run("ToWriter with writer error", func(t *testing.T) {
+		var err = errors.New("disk full")
+		checkObservableResults(t, Pipe1(
+			Of2("a", "b"),
+			ToWriter[string](failingWriter{err}, lineEncoder),
+		), []int{}, err, false)
+	})
+}
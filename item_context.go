@@ -0,0 +1,246 @@
+package rxgo
+
+import (
+	"context"
+	"reflect"
+	"sync/atomic"
+)
+
+// Envelope carries a value along with its own context, so the trace and request IDs of the value survive the operator boundaries. See `WithItemContext` and the `*ItemCtx` operators.
+type Envelope[T any] struct {
+	Ctx   context.Context
+	Value T
+}
+
+// Context returns the context of the value, `context.Background()` if it has none.
+func (e Envelope[T]) Context() context.Context {
+	if e.Ctx == nil {
+		return context.Background()
+	}
+	return e.Ctx
+}
+
+// Tracer starts the spans of the stages processing the values carried by an `Envelope`, it's implemented by adapting any tracing library. Set it with `SetTracer`.
+type Tracer interface {
+	// Start starts a span named after the stage, as a child of the span of the context if any, and returns a context carrying the new span.
+	Start(ctx context.Context, stage string) (context.Context, Span)
+}
+
+// Span is started by a `Tracer` for every value processed by a stage.
+type Span interface {
+	RecordError(err error)
+	End()
+}
+
+type tracerHolder struct {
+	tracer Tracer
+}
+
+var globalTracer atomic.Pointer[tracerHolder]
+
+// SetTracer replaces the tracer of the `*ItemCtx` operators, none is set by default.
+func SetTracer(tracer Tracer) {
+	globalTracer.Store(&tracerHolder{tracer})
+}
+
+// ResetTracer removes the tracer.
+func ResetTracer() {
+	globalTracer.Store(nil)
+}
+
+// GetTracer returns the tracer of the `*ItemCtx` operators.
+func GetTracer() Tracer {
+	if holder := globalTracer.Load(); holder != nil {
+		return holder.tracer
+	}
+	return noopTracer{}
+}
+
+type noopTracer struct{}
+
+func (noopTracer) Start(ctx context.Context, _ string) (context.Context, Span) {
+	return ctx, noopSpan{}
+}
+
+type noopSpan struct{}
+
+func (noopSpan) RecordError(error) {}
+
+func (noopSpan) End() {}
+
+// Attaches the same context to every value emitted by the source Observable.
+func WithItemContext[T any](ctx context.Context) OperatorFunc[T, Envelope[T]] {
+	return WithItemContextFunc(func(T, uint) context.Context {
+		return ctx
+	})
+}
+
+// Attaches the context returned by `fn` to every value emitted by the source Observable, such as the context of the request a value comes from.
+func WithItemContextFunc[T any](fn func(value T, index uint) context.Context) OperatorFunc[T, Envelope[T]] {
+	if fn == nil {
+		panic(`rxgo: "WithItemContextFunc" expected fn func`)
+	}
+	return Map(func(v T, index uint) (Envelope[T], error) {
+		return Envelope[T]{Ctx: fn(v, index), Value: v}, nil
+	})
+}
+
+// Detaches the context of every value emitted by the source Observable.
+func WithoutItemContext[T any]() OperatorFunc[Envelope[T], T] {
+	return Map(func(item Envelope[T], _ uint) (T, error) {
+		return item.Value, nil
+	})
+}
+
+// Same as `Map`, but the mapper receives the context of the value, within a span named after the stage, "Map" if it's empty. The output value carries the context of the input value.
+func MapItemCtx[T any, R any](stage string, mapper func(ctx context.Context, value T, index uint) (R, error)) OperatorFunc[Envelope[T], Envelope[R]] {
+	if mapper == nil {
+		panic(`rxgo: "MapItemCtx" expected mapper func`)
+	}
+	stage = stageOrDefault(stage, "Map")
+	return Map(func(item Envelope[T], index uint) (Envelope[R], error) {
+		ctx, span := GetTracer().Start(item.Context(), stage)
+		defer span.End()
+
+		v, err := mapper(ctx, item.Value, index)
+		if err != nil {
+			span.RecordError(err)
+			return Envelope[R]{}, err
+		}
+		return Envelope[R]{Ctx: item.Ctx, Value: v}, nil
+	})
+}
+
+// Same as `Filter`, but the predicate receives the context of the value, within a span named after the stage, "Filter" if it's empty.
+func FilterItemCtx[T any](stage string, predicate PredicateCtxFunc[T]) OperatorFunc[Envelope[T], Envelope[T]] {
+	if predicate == nil {
+		panic(`rxgo: "FilterItemCtx" expected predicate func`)
+	}
+	stage = stageOrDefault(stage, "Filter")
+	return Filter(func(item Envelope[T], index uint) bool {
+		ctx, span := GetTracer().Start(item.Context(), stage)
+		defer span.End()
+
+		return predicate(ctx, item.Value, index)
+	})
+}
+
+// Same as `MergeMap`, but the projection receives the context of the value, within a span named after the stage, "MergeMap" if it's empty, which lasts until the projected Observable terminates. The values of the projected Observable carry the context of the input value.
+func MergeMapItemCtx[T any, R any](stage string, project ProjectionCtxFunc[T, R], concurrent ...uint) OperatorFunc[Envelope[T], Envelope[R]] {
+	if project == nil {
+		panic(`rxgo: "MergeMapItemCtx" expected project func`)
+	}
+	stage = stageOrDefault(stage, "MergeMap")
+	return MergeMap(func(item Envelope[T], index uint) Observable[Envelope[R]] {
+		ctx, span := GetTracer().Start(item.Context(), stage)
+
+		return Pipe3(
+			project(ctx, item.Value, index),
+			Map(func(v R, _ uint) (Envelope[R], error) {
+				return Envelope[R]{Ctx: item.Ctx, Value: v}, nil
+			}),
+			TapNotification(func(n ObservableNotification[Envelope[R]]) {
+				if err := n.Err(); err != nil {
+					span.RecordError(err)
+				}
+			}),
+			Finalize[Envelope[R]](span.End),
+		)
+	}, concurrent...)
+}
+
+// Lifts a buffering operator, such as `BufferCount` or `BufferTime`, so it emits a single `Envelope` per buffer. The context of the buffer merges the contexts of its values: the values are looked up in every context in order, and the cancellation follows the context of the first value.
+func BufferItemCtx[T any](buffer OperatorFunc[Envelope[T], []Envelope[T]]) OperatorFunc[Envelope[T], Envelope[[]T]] {
+	if buffer == nil {
+		panic(`rxgo: "BufferItemCtx" expected buffer func`)
+	}
+	return func(source Observable[Envelope[T]]) Observable[Envelope[[]T]] {
+		return Pipe2(source, buffer, Map(func(items []Envelope[T], _ uint) (Envelope[[]T], error) {
+			return mergeEnvelopes(items), nil
+		}))
+	}
+}
+
+// Same as `Zip`, but every combination carries the merged contexts of its values, see `BufferItemCtx`.
+func ZipItemCtx[T any](sources ...Observable[Envelope[T]]) Observable[Envelope[[]T]] {
+	return Pipe1(Zip(sources...), Map(func(items []Envelope[T], _ uint) (Envelope[[]T], error) {
+		return mergeEnvelopes(items), nil
+	}))
+}
+
+// Same as `CombineLatest`, but every combination carries the merged contexts of its values, see `BufferItemCtx`.
+func CombineLatestItemCtx[T any](sources ...Observable[Envelope[T]]) Observable[Envelope[[]T]] {
+	return Pipe1(CombineLatest(sources...), Map(func(items []Envelope[T], _ uint) (Envelope[[]T], error) {
+		return mergeEnvelopes(items), nil
+	}))
+}
+
+func stageOrDefault(stage, operator string) string {
+	if stage == "" {
+		return operator
+	}
+	return stage
+}
+
+func mergeEnvelopes[T any](items []Envelope[T]) Envelope[[]T] {
+	var (
+		values = make([]T, len(items))
+		ctxs   = make([]context.Context, 0, len(items))
+	)
+	for i, item := range items {
+		values[i] = item.Value
+		if item.Ctx != nil {
+			ctxs = append(ctxs, item.Ctx)
+		}
+	}
+	return Envelope[[]T]{Ctx: mergeContexts(ctxs), Value: values}
+}
+
+// mergedContext looks up the values in every context in order, the deadline
+// and the cancellation are the ones of the first context.
+type mergedContext struct {
+	context.Context
+	others []context.Context
+}
+
+func (c mergedContext) Value(key any) any {
+	if v := c.Context.Value(key); v != nil {
+		return v
+	}
+	for _, ctx := range c.others {
+		if v := ctx.Value(key); v != nil {
+			return v
+		}
+	}
+	return nil
+}
+
+func mergeContexts(ctxs []context.Context) context.Context {
+	if len(ctxs) == 0 {
+		return nil
+	}
+
+	// the values of a buffer usually share the same context
+	distinct := ctxs[:1:1]
+	for _, ctx := range ctxs[1:] {
+		if !containsContext(distinct, ctx) {
+			distinct = append(distinct, ctx)
+		}
+	}
+	if len(distinct) == 1 {
+		return distinct[0]
+	}
+	return mergedContext{Context: distinct[0], others: distinct[1:]}
+}
+
+func containsContext(ctxs []context.Context, ctx context.Context) bool {
+	if !reflect.TypeOf(ctx).Comparable() {
+		return false
+	}
+	for _, c := range ctxs {
+		if reflect.TypeOf(c) == reflect.TypeOf(ctx) && c == ctx {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,192 @@
+package rxgo
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type requestIDKey struct{}
+
+func withRequestID(id string) context.Context {
+	return context.WithValue(context.Background(), requestIDKey{}, id)
+}
+
+func requestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+type countingTracer struct {
+	mu     sync.Mutex
+	stages []string
+	errs   []error
+	ended  int
+}
+
+func (t *countingTracer) Start(ctx context.Context, stage string) (context.Context, Span) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.stages = append(t.stages, stage)
+	return ctx, t
+}
+
+func (t *countingTracer) RecordError(err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.errs = append(t.errs, err)
+}
+
+func (t *countingTracer) End() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.ended++
+}
+
+func TestItemContext(t *testing.T) {
+	t.Run("WithItemContext and WithoutItemContext", func(t *testing.T) {
+		ctx := withRequestID("req")
+		checkObservableResults(t, Pipe2(
+			Of2(1, 2, 3),
+			WithItemContext[int](ctx),
+			MapItemCtx("id", func(ctx context.Context, v int, _ uint) (string, error) {
+				return requestID(ctx), nil
+			}),
+		), []Envelope[string]{{ctx, "req"}, {ctx, "req"}, {ctx, "req"}}, nil, true)
+
+		checkObservableResults(t, Pipe2(
+			Of2(1, 2, 3),
+			WithItemContext[int](ctx),
+			WithoutItemContext[int](),
+		), []int{1, 2, 3}, nil, true)
+	})
+
+	t.Run("Envelope without context", func(t *testing.T) {
+		require.Equal(t, context.Background(), Envelope[int]{}.Context())
+	})
+
+	t.Run("MapItemCtx and FilterItemCtx", func(t *testing.T) {
+		var result []string
+		Pipe3(
+			Of2("a", "b", "c"),
+			WithItemContextFunc(func(v string, _ uint) context.Context {
+				return withRequestID("req-" + v)
+			}),
+			FilterItemCtx("skip", func(ctx context.Context, _ string, _ uint) bool {
+				return requestID(ctx) != "req-b"
+			}),
+			MapItemCtx("format", func(ctx context.Context, v string, _ uint) (string, error) {
+				return v + ":" + requestID(ctx), nil
+			}),
+		).SubscribeSync(func(item Envelope[string]) {
+			result = append(result, item.Value+"/"+requestID(item.Context()))
+		}, nil, nil)
+		require.Equal(t, []string{"a:req-a/req-a", "c:req-c/req-c"}, result)
+	})
+
+	t.Run("MergeMapItemCtx", func(t *testing.T) {
+		var (
+			mu     sync.Mutex
+			result = make(map[string][]int)
+		)
+		Pipe2(
+			Of2("x", "y"),
+			WithItemContextFunc(func(v string, _ uint) context.Context {
+				return withRequestID(v)
+			}),
+			MergeMapItemCtx("fetch", func(ctx context.Context, v string, _ uint) Observable[int] {
+				return Of2(1, 2)
+			}),
+		).SubscribeSync(func(item Envelope[int]) {
+			mu.Lock()
+			defer mu.Unlock()
+			id := requestID(item.Context())
+			result[id] = append(result[id], item.Value)
+		}, nil, nil)
+		require.Equal(t, map[string][]int{"x": {1, 2}, "y": {1, 2}}, result)
+	})
+
+	t.Run("BufferItemCtx merges the contexts", func(t *testing.T) {
+		type otherKey struct{}
+		var (
+			ctx1 = withRequestID("req")
+			ctx2 = context.WithValue(context.Background(), otherKey{}, "other")
+		)
+
+		var result []Envelope[[]int]
+		Pipe1(
+			Of2(Envelope[int]{ctx1, 1}, Envelope[int]{ctx1, 2}, Envelope[int]{ctx2, 3}),
+			BufferItemCtx(BufferCount[Envelope[int]](2)),
+		).SubscribeSync(func(item Envelope[[]int]) {
+			result = append(result, item)
+		}, nil, nil)
+
+		require.Len(t, result, 2)
+		require.Equal(t, []int{1, 2}, result[0].Value)
+		require.Equal(t, ctx1, result[0].Ctx)
+		require.Equal(t, []int{3}, result[1].Value)
+		require.Equal(t, ctx2, result[1].Ctx)
+
+		merged := mergeContexts([]context.Context{ctx1, ctx2})
+		require.Equal(t, "req", requestID(merged))
+		require.Equal(t, "other", merged.Value(otherKey{}))
+		require.Nil(t, merged.Value("unknown"))
+	})
+
+	t.Run("ZipItemCtx and CombineLatestItemCtx", func(t *testing.T) {
+		type otherKey struct{}
+		var (
+			ctx1 = withRequestID("req")
+			ctx2 = context.WithValue(context.Background(), otherKey{}, "other")
+		)
+
+		for _, obs := range []Observable[Envelope[[]int]]{
+			ZipItemCtx(Of2(Envelope[int]{ctx1, 1}), Of2(Envelope[int]{ctx2, 2})),
+			CombineLatestItemCtx(Of2(Envelope[int]{ctx1, 1}), Of2(Envelope[int]{ctx2, 2})),
+		} {
+			var result []Envelope[[]int]
+			obs.SubscribeSync(func(item Envelope[[]int]) {
+				result = append(result, item)
+			}, nil, nil)
+
+			require.Len(t, result, 1)
+			require.Equal(t, []int{1, 2}, result[0].Value)
+			require.Equal(t, "req", requestID(result[0].Context()))
+			require.Equal(t, "other", result[0].Context().Value(otherKey{}))
+		}
+	})
+
+	t.Run("Tracer", func(t *testing.T) {
+		tracer := new(countingTracer)
+		SetTracer(tracer)
+		t.Cleanup(ResetTracer)
+
+		err := errors.New("failed")
+		checkObservableResults(t, Pipe4(
+			Of2(1, 2, 3),
+			WithItemContext[int](context.Background()),
+			FilterItemCtx("", func(_ context.Context, v int, _ uint) bool {
+				return v < 3
+			}),
+			MergeMapItemCtx("", func(_ context.Context, v int, _ uint) Observable[int] {
+				return Of2(v)
+			}, 1),
+			MapItemCtx("", func(_ context.Context, v int, _ uint) (int, error) {
+				if v == 2 {
+					return 0, err
+				}
+				return v, nil
+			}),
+		), []Envelope[int]{{context.Background(), 1}}, err, false)
+
+		tracer.mu.Lock()
+		defer tracer.mu.Unlock()
+		require.Contains(t, tracer.stages, "Filter")
+		require.Contains(t, tracer.stages, "MergeMap")
+		require.Contains(t, tracer.stages, "Map")
+		require.Equal(t, []error{err}, tracer.errs)
+	})
+}
@@ -132,7 +132,7 @@ func CombineLatestAll[T any, R any](project func(values []T) R) OperatorFunc[Obs
 func CombineLatestWith[T any](sources ...Observable[T]) OperatorFunc[T, []T] {
 	return func(source Observable[T]) Observable[[]T] {
 		sources = append([]Observable[T]{source}, sources...)
-		return newObservable(func(subscriber Subscriber[[]T]) {
+		return linkGraph(newObservable(func(subscriber Subscriber[[]T]) {
 			var (
 				mu           = new(sync.RWMutex)
 				noOfSource   = len(sources)
@@ -144,8 +144,11 @@ func CombineLatestWith[T any](sources ...Observable[T]) OperatorFunc[T, []T] {
 			onNext := func() {
 				if emitCount.Load() == uint32(noOfSource) {
 					mu.RLock()
-					Next(latestValues).Send(subscriber)
+					// emit a copy, the latest values keep changing after it's sent
+					values := make([]T, noOfSource)
+					copy(values, latestValues)
 					mu.RUnlock()
+					Next(values).Send(subscriber)
 				}
 			}
 
@@ -210,7 +213,7 @@ func CombineLatestWith[T any](sources ...Observable[T]) OperatorFunc[T, []T] {
 
 			// don't complete if it's not complete signal
 			Complete[[]T]().Send(subscriber)
-		})
+		}), sources...)
 	}
 }
 
@@ -303,7 +306,7 @@ func ConcatAll[T any]() OperatorFunc[Observable[T], T] {
 func ConcatWith[T any](sources ...Observable[T]) OperatorFunc[T, T] {
 	return func(source Observable[T]) Observable[T] {
 		sources = append([]Observable[T]{source}, sources...)
-		return newObservable(func(subscriber Subscriber[T]) {
+		return linkGraph(newObservable(func(subscriber Subscriber[T]) {
 			var (
 				wg  = new(sync.WaitGroup)
 				err error
@@ -350,7 +353,7 @@ func ConcatWith[T any](sources ...Observable[T]) OperatorFunc[T, T] {
 			}
 
 			wg.Wait()
-		})
+		}), sources...)
 	}
 }
 
@@ -361,9 +364,9 @@ func ExhaustAll[T any]() OperatorFunc[Observable[T], T] {
 	})
 }
 
-// Accepts an Array of ObservableInput or a dictionary Object of ObservableInput and returns an Observable that emits either an array of values in the exact same order as the passed array, or a dictionary of values in the same shape as the passed dictionary.
+// Accepts an Array of ObservableInput or a dictionary Object of ObservableInput and returns an Observable that emits either an array of values in the exact same order as the passed array, or a dictionary of values in the same shape as the passed dictionary. The dictionary form is provided by `ForkJoinMap`.
 func ForkJoin[T any](sources ...Observable[T]) Observable[[]T] {
-	return newObservable(func(subscriber Subscriber[[]T]) {
+	return linkGraph(newObservable(func(subscriber Subscriber[[]T]) {
 		var (
 			noOfSource = len(sources)
 		)
@@ -376,6 +379,7 @@ func ForkJoin[T any](sources ...Observable[T]) Observable[[]T] {
 
 		var (
 			emitCount    = new(atomic.Uint32)
+			failed       = new(atomic.Bool)
 			mu           = new(sync.RWMutex)
 			g, ctx       = errgroup.WithContext(context.TODO())
 			latestValues = make([]T, noOfSource)
@@ -413,6 +417,10 @@ func ForkJoin[T any](sources ...Observable[T]) Observable[[]T] {
 
 						// if one error, everything error
 						if err := item.Err(); err != nil {
+							// only the first error is returned by the group
+							if !failed.CompareAndSwap(false, true) {
+								onUndeliverableError(err)
+							}
 							return err
 						}
 
@@ -450,14 +458,21 @@ func ForkJoin[T any](sources ...Observable[T]) Observable[[]T] {
 		}
 
 		Complete[[]T]().Send(subscriber)
-	})
+	}), sources...)
+}
+
+// Converts a higher-order Observable into a first-order Observable which concurrently delivers all values that are emitted on the inner Observables. At most `concurrent` inner Observables are subscribed to at the same time, the others are queued.
+func MergeAll[T any](concurrent ...uint) OperatorFunc[Observable[T], T] {
+	return MergeMap(func(value Observable[T], _ uint) Observable[T] {
+		return value
+	}, concurrent...)
 }
 
 // FIXME: Merge the values from all observables to a single observable result.
 func MergeWith[T any](input Observable[T], inputs ...Observable[T]) OperatorFunc[T, T] {
 	return func(source Observable[T]) Observable[T] {
 		inputs = append([]Observable[T]{source, input}, inputs...)
-		return newObservable(func(subscriber Subscriber[T]) {
+		return linkGraph(newObservable(func(subscriber Subscriber[T]) {
 			var (
 				wg                  = new(sync.WaitGroup)
 				mu                  = new(sync.RWMutex)
@@ -555,7 +570,7 @@ func MergeWith[T any](input Observable[T], inputs ...Observable[T]) OperatorFunc
 			}
 
 			Complete[T]().Send(subscriber)
-		})
+		}), inputs...)
 	}
 }
 
@@ -563,7 +578,7 @@ func MergeWith[T any](input Observable[T], inputs ...Observable[T]) OperatorFunc
 func RaceWith[T any](sources ...Observable[T]) OperatorFunc[T, T] {
 	return func(source Observable[T]) Observable[T] {
 		sources = append([]Observable[T]{source}, sources...)
-		return newObservable(func(subscriber Subscriber[T]) {
+		return linkGraph(newObservable(func(subscriber Subscriber[T]) {
 			var (
 				wg            = new(sync.WaitGroup)
 				mu            = new(sync.RWMutex)
@@ -643,7 +658,7 @@ func RaceWith[T any](sources ...Observable[T]) OperatorFunc[T, T] {
 			}
 
 			wg.Wait()
-		})
+		}), sources...)
 	}
 }
 
@@ -682,7 +697,7 @@ func SwitchAll[T any]() OperatorFunc[Observable[T], T] {
 // Combines the source Observable with other Observables to create an Observable whose values are calculated from the latest values of each, only when the source emits.
 func WithLatestFrom[A any, B any](input Observable[B]) OperatorFunc[A, Tuple[A, B]] {
 	return func(source Observable[A]) Observable[Tuple[A, B]] {
-		return newObservable(func(subscriber Subscriber[Tuple[A, B]]) {
+		return linkGraph(newObservable(func(subscriber Subscriber[Tuple[A, B]]) {
 			var (
 				allOk       [2]bool
 				errOnce     = new(atomic.Pointer[error])
@@ -762,7 +777,7 @@ func WithLatestFrom[A any, B any](input Observable[B]) OperatorFunc[A, Tuple[A,
 				Error[Tuple[A, B]](*err).Send(subscriber)
 				return
 			}
-		})
+		}), input)
 	}
 }
 
@@ -873,6 +888,9 @@ func ZipAll[T any]() OperatorFunc[Observable[T], []T] {
 					// reset the values for next loop
 					setupValues()
 				}
+			} else if runNext {
+				// the source completed without any inner source
+				Complete[[]T]().Send(subscriber)
 			}
 
 			wg.Wait()
@@ -884,7 +902,7 @@ func ZipAll[T any]() OperatorFunc[Observable[T], []T] {
 func ZipWith[T any](input Observable[T], inputs ...Observable[T]) OperatorFunc[T, []T] {
 	return func(source Observable[T]) Observable[[]T] {
 		inputs = append([]Observable[T]{source, input}, inputs...)
-		return newObservable(func(subscriber Subscriber[[]T]) {
+		return linkGraph(newObservable(func(subscriber Subscriber[[]T]) {
 			var (
 				wg         = new(sync.WaitGroup)
 				noOfSource = uint(len(inputs))
@@ -957,6 +975,216 @@ func ZipWith[T any](input Observable[T], inputs ...Observable[T]) OperatorFunc[T
 			}
 
 			wg.Wait()
-		})
+		}), inputs...)
 	}
 }
+
+// Combines two Observables of different types by index, and emits a Tuple for every pair of values.
+func Zip2[A any, B any](a Observable[A], b Observable[B]) Observable[Tuple[A, B]] {
+	return Zip2Func(a, b, NewTuple[A, B])
+}
+
+// Combines two Observables of different types by index, and emits the projection of every pair of values.
+func Zip2Func[A any, B any, R any](a Observable[A], b Observable[B], project func(A, B) R) Observable[R] {
+	return projectValues(ZipWith(toAny(b))(toAny(a)), func(v []any) R {
+		return project(fromAny[A](v[0]), fromAny[B](v[1]))
+	}, a, b)
+}
+
+// Combines three Observables of different types by index, and emits a Tuple3 for every group of values.
+func Zip3[A any, B any, C any](a Observable[A], b Observable[B], c Observable[C]) Observable[Tuple3[A, B, C]] {
+	return Zip3Func(a, b, c, NewTuple3[A, B, C])
+}
+
+// Combines three Observables of different types by index, and emits the projection of every group of values.
+func Zip3Func[A any, B any, C any, R any](a Observable[A], b Observable[B], c Observable[C], project func(A, B, C) R) Observable[R] {
+	return projectValues(ZipWith(toAny(b), toAny(c))(toAny(a)), func(v []any) R {
+		return project(fromAny[A](v[0]), fromAny[B](v[1]), fromAny[C](v[2]))
+	}, a, b, c)
+}
+
+// Combines the latest values of two Observables of different types, and emits a Tuple whenever any of them emits.
+func CombineLatest2[A any, B any](a Observable[A], b Observable[B]) Observable[Tuple[A, B]] {
+	return CombineLatest2Func(a, b, NewTuple[A, B])
+}
+
+// Combines the latest values of two Observables of different types, and emits their projection whenever any of them emits.
+func CombineLatest2Func[A any, B any, R any](a Observable[A], b Observable[B], project func(A, B) R) Observable[R] {
+	return projectValues(CombineLatestWith(toAny(b))(toAny(a)), func(v []any) R {
+		return project(fromAny[A](v[0]), fromAny[B](v[1]))
+	}, a, b)
+}
+
+// Combines the latest values of three Observables of different types, and emits a Tuple3 whenever any of them emits.
+func CombineLatest3[A any, B any, C any](a Observable[A], b Observable[B], c Observable[C]) Observable[Tuple3[A, B, C]] {
+	return CombineLatest3Func(a, b, c, NewTuple3[A, B, C])
+}
+
+// Combines the latest values of three Observables of different types, and emits their projection whenever any of them emits.
+func CombineLatest3Func[A any, B any, C any, R any](a Observable[A], b Observable[B], c Observable[C], project func(A, B, C) R) Observable[R] {
+	return projectValues(CombineLatestWith(toAny(b), toAny(c))(toAny(a)), func(v []any) R {
+		return project(fromAny[A](v[0]), fromAny[B](v[1]), fromAny[C](v[2]))
+	}, a, b, c)
+}
+
+// Waits for two Observables of different types to complete, and emits a Tuple of their last values.
+func ForkJoin2[A any, B any](a Observable[A], b Observable[B]) Observable[Tuple[A, B]] {
+	return ForkJoin2Func(a, b, NewTuple[A, B])
+}
+
+// Waits for two Observables of different types to complete, and emits the projection of their last values.
+func ForkJoin2Func[A any, B any, R any](a Observable[A], b Observable[B], project func(A, B) R) Observable[R] {
+	return projectValues(ForkJoin(toAny(a), toAny(b)), func(v []any) R {
+		return project(fromAny[A](v[0]), fromAny[B](v[1]))
+	}, a, b)
+}
+
+// Waits for three Observables of different types to complete, and emits a Tuple3 of their last values.
+func ForkJoin3[A any, B any, C any](a Observable[A], b Observable[B], c Observable[C]) Observable[Tuple3[A, B, C]] {
+	return ForkJoin3Func(a, b, c, NewTuple3[A, B, C])
+}
+
+// Waits for three Observables of different types to complete, and emits the projection of their last values.
+func ForkJoin3Func[A any, B any, C any, R any](a Observable[A], b Observable[B], c Observable[C], project func(A, B, C) R) Observable[R] {
+	return projectValues(ForkJoin(toAny(a), toAny(b), toAny(c)), func(v []any) R {
+		return project(fromAny[A](v[0]), fromAny[B](v[1]), fromAny[C](v[2]))
+	}, a, b, c)
+}
+
+// toAny erases the type of the values, so Observables of different types can
+// be combined by the operators working on a single type.
+func toAny[T any](source Observable[T]) Observable[any] {
+	return Pipe1(source, Map(func(v T, _ uint) (any, error) {
+		return v, nil
+	}))
+}
+
+// fromAny restores the type erased by `toAny`.
+func fromAny[T any](v any) T {
+	if v == nil {
+		return *new(T)
+	}
+	return v.(T)
+}
+
+// projectValues projects the values combined from the inputs, the internal
+// Observables are hidden from the graph.
+func projectValues[R any](source Observable[[]any], project func([]any) R, inputs ...any) Observable[R] {
+	return relinkGraphAny(Pipe1(source, Map(func(v []any, _ uint) (R, error) {
+		return project(v), nil
+	})), inputs...)
+}
+
+// Accepts a map of Observables, waits for all of them to complete and emits a map with the last value of every Observable under the same key. If any of the Observables completes without emitting a value, nothing is emitted. With the default `StopOnError` strategy, the first error unsubscribes the other Observables and is propagated as it is; with `ContinueOnError`, every Observable runs to its end and all the errors are propagated together as a `ForkJoinError`.
+func ForkJoinMap[K comparable, T any](sources map[K]Observable[T], strategy ...OnErrorStrategy) Observable[map[K]T] {
+	errorStrategy := StopOnError
+	if len(strategy) > 0 {
+		errorStrategy = strategy[0]
+	}
+	inputs := make([]Observable[T], 0, len(sources))
+	for _, source := range sources {
+		inputs = append(inputs, source)
+	}
+	return linkGraph(newObservable(func(subscriber Subscriber[map[K]T]) {
+		var (
+			noOfSource = len(sources)
+		)
+
+		// if no input observables are provided, then the resulting stream will complete immediately.
+		if noOfSource < 1 {
+			Complete[map[K]T]().Send(subscriber)
+			return
+		}
+
+		var (
+			wg           = new(sync.WaitGroup)
+			mu           = new(sync.Mutex)
+			ctx, cancel  = context.WithCancel(context.TODO())
+			latestValues = make(map[K]T, noOfSource)
+			errs         = make(map[K]error)
+			firstErr     error
+		)
+
+		defer cancel()
+
+		onError := func(key K, err error) {
+			mu.Lock()
+			errs[key] = err
+			if firstErr == nil {
+				firstErr = err
+			}
+			mu.Unlock()
+			// fail fast, unsubscribe from the others
+			if errorStrategy == StopOnError {
+				cancel()
+			}
+		}
+
+		observeStream := func(key K, obs Observable[T]) {
+			defer wg.Done()
+
+			var (
+				value    T
+				emitted  bool
+				upStream = obs.SubscribeOn()
+			)
+
+		loop:
+			for {
+				select {
+				case <-ctx.Done():
+					upStream.Stop()
+					return
+
+				case <-subscriber.Closed():
+					upStream.Stop()
+					return
+
+				case item, ok := <-upStream.ForEach():
+					if !ok {
+						break loop
+					}
+
+					if err := item.Err(); err != nil {
+						onError(key, err)
+						return
+					}
+
+					if item.Done() {
+						break loop
+					}
+
+					value, emitted = item.Value(), true
+				}
+			}
+
+			if emitted {
+				mu.Lock()
+				latestValues[key] = value
+				mu.Unlock()
+			}
+		}
+
+		wg.Add(noOfSource)
+		for key, source := range sources {
+			go observeStream(key, source)
+		}
+
+		wg.Wait()
+
+		if firstErr != nil {
+			if errorStrategy == StopOnError {
+				Error[map[K]T](firstErr).Send(subscriber)
+				return
+			}
+			Error[map[K]T](ForkJoinError[K]{Errors: errs}).Send(subscriber)
+			return
+		}
+
+		if len(latestValues) == noOfSource {
+			Next(latestValues).Send(subscriber)
+		}
+
+		Complete[map[K]T]().Send(subscriber)
+	}), inputs...)
+}
@@ -0,0 +1,77 @@
+package rxgo
+
+// Creates an output Observable which concurrently emits all values from every given input Observable. If no input Observable is given, the output Observable completes immediately.
+func Merge[T any](sources ...Observable[T]) Observable[T] {
+	return relinkGraph(MergeFrom(fromSlice(sources)), sources...)
+}
+
+// Same as `Merge`, but the input Observables are emitted by the source, and every one of them is subscribed as soon as it arrives.
+func MergeFrom[T any](sources Observable[Observable[T]]) Observable[T] {
+	return Pipe1(sources, MergeAll[T]())
+}
+
+// Creates an output Observable which sequentially emits all values from the first given Observable and then moves on to the next. If no input Observable is given, the output Observable completes immediately.
+func Concat[T any](sources ...Observable[T]) Observable[T] {
+	return relinkGraph(ConcatFrom(fromSlice(sources)), sources...)
+}
+
+// Same as `Concat`, but the input Observables are emitted by the source, and they are subscribed one after another in the order they arrive.
+func ConcatFrom[T any](sources Observable[Observable[T]]) Observable[T] {
+	return Pipe1(sources, ConcatAll[T]())
+}
+
+// Returns an Observable that mirrors the first source Observable to emit a next, error or complete notification. If no input Observable is given, the output Observable completes immediately.
+func Race[T any](sources ...Observable[T]) Observable[T] {
+	if len(sources) < 1 {
+		return Empty[T]()
+	}
+	return Pipe1(sources[0], RaceWith(sources[1:]...))
+}
+
+// Same as `Race`, but the input Observables are emitted by the source. The race starts once the source completes.
+func RaceFrom[T any](sources Observable[Observable[T]]) Observable[T] {
+	return Pipe2(sources, ToSlice[Observable[T]](), ConcatMap(func(v []Observable[T], _ uint) Observable[T] {
+		return Race(v...)
+	}))
+}
+
+// Combines multiple Observables to create an Observable whose values are calculated from the values, in order, of each of its input Observables. If no input Observable is given, the output Observable completes immediately.
+func Zip[T any](sources ...Observable[T]) Observable[[]T] {
+	return relinkGraph(ZipFrom(fromSlice(sources)), sources...)
+}
+
+// Same as `Zip`, but the input Observables are emitted by the source. The input Observables are subscribed once the source completes.
+func ZipFrom[T any](sources Observable[Observable[T]]) Observable[[]T] {
+	return Pipe1(sources, ZipAll[T]())
+}
+
+// Combines multiple Observables to create an Observable whose values are calculated from the latest values of each of its input Observables. If no input Observable is given, the output Observable completes immediately.
+func CombineLatest[T any](sources ...Observable[T]) Observable[[]T] {
+	return relinkGraph(CombineLatestFrom(fromSlice(sources)), sources...)
+}
+
+// Same as `CombineLatest`, but the input Observables are emitted by the source. The input Observables are subscribed once the source completes.
+func CombineLatestFrom[T any](sources Observable[Observable[T]]) Observable[[]T] {
+	return Pipe1(sources, CombineLatestAll(func(values []T) []T {
+		// emit a copy, the latest values keep changing after it's sent
+		result := make([]T, len(values))
+		copy(result, values)
+		return result
+	}))
+}
+
+// fromSlice emits every item of the slice, it completes immediately if the slice is empty.
+func fromSlice[T any](items []T) Observable[T] {
+	return newObservable(func(subscriber Subscriber[T]) {
+		for _, item := range items {
+			select {
+			// If receiver notify stop, we should terminate the operation
+			case <-subscriber.Closed():
+				return
+			case subscriber.Send() <- Next(item):
+			}
+		}
+
+		Complete[T]().Send(subscriber)
+	})
+}
@@ -0,0 +1,159 @@
+package rxgo
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestMerge(t *testing.T) {
+	t.Run("Merge without sources", func(t *testing.T) {
+		checkObservableResults(t, Merge[uint](), []uint{}, nil, true)
+	})
+
+	t.Run("Merge with error", func(t *testing.T) {
+		var err = errors.New("failed")
+		checkObservableHasResults(t, Merge(
+			Pipe1(Interval(time.Millisecond), Take[uint](3)),
+			Throw[uint](func() error {
+				return err
+			}),
+		), false, err, false)
+	})
+
+	t.Run("Merge with values", func(t *testing.T) {
+		checkObservableResults(t, Merge(
+			Of2[uint](1, 2),
+			Range[uint](10, 3),
+			Empty[uint](),
+		), []uint{1, 2, 10, 11, 12}, nil, true)
+	})
+
+	t.Run("MergeFrom with dynamic sources", func(t *testing.T) {
+		checkObservableResults(t, MergeFrom(Pipe2(
+			Interval(time.Millisecond*10),
+			Take[uint](3),
+			Map(func(v, _ uint) (Observable[uint], error) {
+				return Of2(v*10, v*10+1), nil
+			}),
+		)), []uint{0, 1, 10, 11, 20, 21}, nil, true)
+	})
+}
+
+func TestConcat(t *testing.T) {
+	t.Run("Concat without sources", func(t *testing.T) {
+		checkObservableResults(t, Concat[string](), []string{}, nil, true)
+	})
+
+	t.Run("Concat with error", func(t *testing.T) {
+		var err = errors.New("failed")
+		checkObservableResults(t, Concat(
+			Of2("a"),
+			Throw[string](func() error {
+				return err
+			}),
+			Of2("b"),
+		), []string{"a"}, err, false)
+	})
+
+	t.Run("Concat with values", func(t *testing.T) {
+		checkObservableResults(t, Concat(
+			Pipe1(Interval(time.Millisecond*10), Take[uint](2)),
+			Of2[uint](7),
+			Range[uint](1, 2),
+		), []uint{0, 1, 7, 1, 2}, nil, true)
+	})
+
+	t.Run("ConcatFrom with dynamic sources", func(t *testing.T) {
+		checkObservableResults(t, ConcatFrom(Of2(Of2("a", "b"), Empty[string](), Of2("c"))), []string{"a", "b", "c"}, nil, true)
+	})
+}
+
+func TestRace(t *testing.T) {
+	t.Run("Race without sources", func(t *testing.T) {
+		checkObservableResults(t, Race[uint](), []uint{}, nil, true)
+	})
+
+	t.Run("Race with error", func(t *testing.T) {
+		var err = errors.New("failed")
+		checkObservableResults(t, Race(
+			Pipe1(Interval(time.Millisecond*50), Take[uint](3)),
+			Throw[uint](func() error {
+				return err
+			}),
+		), []uint{}, err, false)
+	})
+
+	t.Run("Race with values", func(t *testing.T) {
+		checkObservableResults(t, Race(
+			Pipe2(Interval(time.Millisecond*100), Map(func(v, _ uint) (uint, error) {
+				return v + 100, nil
+			}), Take[uint](2)),
+			Pipe1(Interval(time.Millisecond*5), Take[uint](2)),
+		), []uint{0, 1}, nil, true)
+	})
+
+	t.Run("RaceFrom with dynamic sources", func(t *testing.T) {
+		checkObservableResults(t, RaceFrom(Of2(
+			Pipe1(Interval(time.Millisecond*100), Take[uint](2)),
+			Of2[uint](8, 9),
+		)), []uint{8, 9}, nil, true)
+	})
+}
+
+func TestZip(t *testing.T) {
+	t.Run("Zip without sources", func(t *testing.T) {
+		checkObservableResults(t, Zip[uint](), [][]uint{}, nil, true)
+	})
+
+	t.Run("Zip with error", func(t *testing.T) {
+		var err = errors.New("failed")
+		checkObservableResults(t, Zip(
+			Of2[uint](1, 2),
+			Throw[uint](func() error {
+				return err
+			}),
+		), [][]uint{}, err, false)
+	})
+
+	t.Run("Zip with values", func(t *testing.T) {
+		checkObservableResults(t, Zip(
+			Of2("a", "b", "c"),
+			Of2("1", "2"),
+		), [][]string{{"a", "1"}, {"b", "2"}}, nil, true)
+	})
+
+	t.Run("ZipFrom with dynamic sources", func(t *testing.T) {
+		checkObservableResults(t, ZipFrom(Of2(Of2(1, 2), Of2(3, 4))), [][]int{{1, 3}, {2, 4}}, nil, true)
+	})
+}
+
+func TestCombineLatest(t *testing.T) {
+	t.Run("CombineLatest without sources", func(t *testing.T) {
+		checkObservableResults(t, CombineLatest[uint](), [][]uint{}, nil, true)
+	})
+
+	t.Run("CombineLatest with error", func(t *testing.T) {
+		var err = errors.New("failed")
+		checkObservableResults(t, CombineLatest(
+			Pipe1(Interval(time.Millisecond*10), Take[uint](2)),
+			Throw[uint](func() error {
+				return err
+			}),
+		), [][]uint{}, err, false)
+	})
+
+	t.Run("CombineLatest with values", func(t *testing.T) {
+		checkObservableResults(t, CombineLatest(
+			Pipe1(Interval(time.Millisecond*50), Take[uint](2)),
+			Of2[uint](88),
+		), [][]uint{{0, 88}, {1, 88}}, nil, true)
+	})
+
+	t.Run("CombineLatestFrom with dynamic sources", func(t *testing.T) {
+		checkObservableResults(t, CombineLatestFrom(Of2(
+			Of2[uint](1),
+			Pipe1(Interval(time.Millisecond*50), Take[uint](2)),
+		)), [][]uint{{1, 0}, {1, 1}}, nil, true)
+	})
+}
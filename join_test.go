@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"testing"
 	"time"
+
+	"github.com/stretchr/testify/require"
 )
 
 func TestCombineLatestAll(t *testing.T) {
@@ -209,6 +211,35 @@ func TestConcatWith(t *testing.T) {
 	})
 }
 
+func TestMergeAll(t *testing.T) {
+	t.Run("MergeAll with Empty", func(t *testing.T) {
+		checkObservableResults(t, Pipe1(
+			Empty[Observable[uint]](),
+			MergeAll[uint](),
+		), []uint{}, nil, true)
+	})
+
+	t.Run("MergeAll with error", func(t *testing.T) {
+		var err = errors.New("failed")
+		checkObservableResults(t, Pipe1(
+			Of2(Of2[uint](1), Throw[uint](func() error {
+				return err
+			})),
+			MergeAll[uint](1),
+		), []uint{1}, err, false)
+	})
+
+	t.Run("MergeAll with concurrent 1", func(t *testing.T) {
+		checkObservableResults(t, Pipe1(
+			Of2(
+				Pipe1(Interval(time.Millisecond*5), Take[uint](3)),
+				Of2[uint](10, 11),
+			),
+			MergeAll[uint](1),
+		), []uint{0, 1, 2, 10, 11}, nil, true)
+	})
+}
+
 func TestExhaustAll(t *testing.T) {
 	t.Run("ExhaustAll with Empty", func(t *testing.T) {
 		checkObservableResults(t, Pipe1(
@@ -633,3 +664,275 @@ func TestZipAll(t *testing.T) {
 		}, nil, true)
 	})
 }
+
+func TestZip2(t *testing.T) {
+	t.Run("Zip2 with Empty", func(t *testing.T) {
+		checkObservableResults(t, Zip2(
+			Empty[string](),
+			Of2(1, 2),
+		), []Tuple[string, int]{}, nil, true)
+	})
+
+	t.Run("Zip2 with error", func(t *testing.T) {
+		var err = errors.New("stop")
+		checkObservableResults(t, Zip2(
+			Of2("a", "b"),
+			Throw[int](func() error {
+				return err
+			}),
+		), []Tuple[string, int]{}, err, false)
+	})
+
+	t.Run("Zip2 with values", func(t *testing.T) {
+		checkObservableResults(t, Zip2(
+			Of2("a", "b", "c"),
+			Of2(1, 2),
+		), []Tuple[string, int]{
+			NewTuple("a", 1),
+			NewTuple("b", 2),
+		}, nil, true)
+	})
+
+	t.Run("Zip2Func with projection", func(t *testing.T) {
+		checkObservableResults(t, Zip2Func(
+			Of2("a", "b"),
+			Of2(1, 2),
+			func(a string, b int) string {
+				return fmt.Sprintf("%s%d", a, b)
+			},
+		), []string{"a1", "b2"}, nil, true)
+	})
+
+	t.Run("Zip2 with nil interface value", func(t *testing.T) {
+		checkObservableResults(t, Zip2(
+			Of2[error](nil),
+			Of2(1),
+		), []Tuple[error, int]{NewTuple[error](nil, 1)}, nil, true)
+	})
+}
+
+func TestZip3(t *testing.T) {
+	t.Run("Zip3 with values", func(t *testing.T) {
+		checkObservableResults(t, Zip3(
+			Of2[uint](27, 25, 29),
+			Of2("Foo", "Bar", "Beer"),
+			Of2(true, true, false),
+		), []Tuple3[uint, string, bool]{
+			NewTuple3[uint](27, "Foo", true),
+			NewTuple3[uint](25, "Bar", true),
+			NewTuple3[uint](29, "Beer", false),
+		}, nil, true)
+	})
+
+	t.Run("Zip3Func with projection", func(t *testing.T) {
+		checkObservableResults(t, Zip3Func(
+			Of2(1, 2),
+			Of2(10, 20),
+			Of2(100, 200),
+			func(a, b, c int) int {
+				return a + b + c
+			},
+		), []int{111, 222}, nil, true)
+	})
+}
+
+func TestCombineLatest2(t *testing.T) {
+	t.Run("CombineLatest2 with Empty", func(t *testing.T) {
+		checkObservableResults(t, CombineLatest2(
+			Empty[string](),
+			Of2(1, 2),
+		), []Tuple[string, int]{}, nil, true)
+	})
+
+	t.Run("CombineLatest2 with error", func(t *testing.T) {
+		var err = errors.New("stop")
+		checkObservableResults(t, CombineLatest2(
+			Pipe1(Interval(time.Millisecond*10), Take[uint](3)),
+			Throw[int](func() error {
+				return err
+			}),
+		), []Tuple[uint, int]{}, err, false)
+	})
+
+	t.Run("CombineLatest2 with values", func(t *testing.T) {
+		checkObservableResults(t, Pipe1(
+			CombineLatest2(
+				Pipe1(Interval(time.Millisecond*50), Take[uint](1)),
+				Of2("only"),
+			),
+			Take[Tuple[uint, string]](1),
+		), []Tuple[uint, string]{NewTuple[uint](0, "only")}, nil, true)
+	})
+
+	t.Run("CombineLatest2Func with projection", func(t *testing.T) {
+		checkObservableResults(t, CombineLatest2Func(
+			Pipe1(Interval(time.Millisecond*50), Take[uint](1)),
+			Of2("x"),
+			func(a uint, b string) string {
+				return fmt.Sprintf("%s%d", b, a)
+			},
+		), []string{"x0"}, nil, true)
+	})
+}
+
+func TestCombineLatest3(t *testing.T) {
+	t.Run("CombineLatest3 with values", func(t *testing.T) {
+		checkObservableResults(t, CombineLatest3(
+			Pipe1(Interval(time.Millisecond*50), Take[uint](1)),
+			Of2("a"),
+			Of2(true),
+		), []Tuple3[uint, string, bool]{NewTuple3[uint](0, "a", true)}, nil, true)
+	})
+
+	t.Run("CombineLatest3Func with projection", func(t *testing.T) {
+		checkObservableResults(t, CombineLatest3Func(
+			Pipe1(Interval(time.Millisecond*50), Take[uint](1)),
+			Of2("a"),
+			Of2(true),
+			func(a uint, b string, c bool) string {
+				return fmt.Sprintf("%d%s%t", a, b, c)
+			},
+		), []string{"0atrue"}, nil, true)
+	})
+}
+
+func TestForkJoin2(t *testing.T) {
+	t.Run("ForkJoin2 with Empty", func(t *testing.T) {
+		checkObservableResults(t, ForkJoin2(
+			Empty[string](),
+			Of2(1, 2),
+		), []Tuple[string, int]{}, nil, true)
+	})
+
+	t.Run("ForkJoin2 with error", func(t *testing.T) {
+		var err = errors.New("stop")
+		checkObservableResults(t, ForkJoin2(
+			Of2("a"),
+			Throw[int](func() error {
+				return err
+			}),
+		), []Tuple[string, int]{}, err, false)
+	})
+
+	t.Run("ForkJoin2 with values", func(t *testing.T) {
+		checkObservableResults(t, ForkJoin2(
+			Of2("a", "b"),
+			Pipe1(Interval(time.Millisecond*10), Take[uint](3)),
+		), []Tuple[string, uint]{NewTuple[string, uint]("b", 2)}, nil, true)
+	})
+
+	t.Run("ForkJoin2Func with projection", func(t *testing.T) {
+		checkObservableResults(t, ForkJoin2Func(
+			Of2("a", "b"),
+			Of2(1, 2),
+			func(a string, b int) string {
+				return fmt.Sprintf("%s%d", a, b)
+			},
+		), []string{"b2"}, nil, true)
+	})
+}
+
+func TestForkJoin3(t *testing.T) {
+	t.Run("ForkJoin3 with values", func(t *testing.T) {
+		checkObservableResults(t, ForkJoin3(
+			Of2("a", "b"),
+			Of2(1, 2),
+			Of2(true),
+		), []Tuple3[string, int, bool]{NewTuple3("b", 2, true)}, nil, true)
+	})
+
+	t.Run("ForkJoin3Func with projection", func(t *testing.T) {
+		checkObservableResults(t, ForkJoin3Func(
+			Of2(1),
+			Of2(2),
+			Of2(3),
+			func(a, b, c int) []int {
+				return []int{a, b, c}
+			},
+		), [][]int{{1, 2, 3}}, nil, true)
+	})
+}
+
+func TestForkJoinMap(t *testing.T) {
+	t.Run("ForkJoinMap with empty map", func(t *testing.T) {
+		checkObservableResults(t, ForkJoinMap(map[string]Observable[uint]{}), []map[string]uint{}, nil, true)
+	})
+
+	t.Run("ForkJoinMap with one Empty", func(t *testing.T) {
+		checkObservableResults(t, ForkJoinMap(map[string]Observable[uint]{
+			"user":    Empty[uint](),
+			"billing": Of2[uint](1, 2),
+		}), []map[string]uint{}, nil, true)
+	})
+
+	t.Run("ForkJoinMap with values", func(t *testing.T) {
+		checkObservableResults(t, ForkJoinMap(map[string]Observable[uint]{
+			"user":      Of2[uint](1, 88),
+			"billing":   Pipe1(Interval(time.Millisecond*10), Take[uint](3)),
+			"inventory": Range[uint](5, 3),
+		}), []map[string]uint{{
+			"user":      88,
+			"billing":   2,
+			"inventory": 7,
+		}}, nil, true)
+	})
+
+	t.Run("ForkJoinMap with error should fail fast", func(t *testing.T) {
+		var err = errors.New("billing is down")
+		start := time.Now()
+		checkObservableResults(t, ForkJoinMap(map[string]Observable[uint]{
+			"user": Interval(time.Millisecond * 10),
+			"billing": Throw[uint](func() error {
+				return err
+			}),
+		}), []map[string]uint{}, err, false)
+		require.Less(t, time.Since(start), time.Second)
+	})
+
+	t.Run("ForkJoinMap with ContinueOnError should collect all errors", func(t *testing.T) {
+		var (
+			errBilling   = errors.New("billing is down")
+			errInventory = errors.New("inventory is down")
+			result       map[string]uint
+			collectedErr error
+		)
+		ForkJoinMap(map[string]Observable[uint]{
+			"user": Pipe1(Interval(time.Millisecond*10), Take[uint](3)),
+			"billing": Throw[uint](func() error {
+				return errBilling
+			}),
+			"inventory": Pipe1(Of2[uint](1), Map(func(v, _ uint) (uint, error) {
+				return 0, errInventory
+			})),
+		}, ContinueOnError).SubscribeSync(func(v map[string]uint) {
+			result = v
+		}, func(err error) {
+			collectedErr = err
+		}, nil)
+
+		require.Nil(t, result)
+		var forkJoinErr ForkJoinError[string]
+		require.ErrorAs(t, collectedErr, &forkJoinErr)
+		require.Equal(t, map[string]error{
+			"billing":   errBilling,
+			"inventory": errInventory,
+		}, forkJoinErr.Errors)
+		require.ErrorIs(t, collectedErr, errBilling)
+		require.ErrorIs(t, collectedErr, errInventory)
+		require.Equal(t, "fork join failed: billing: billing is down; inventory: inventory is down", collectedErr.Error())
+	})
+
+	t.Run("ForkJoinError should match the collected errors", func(t *testing.T) {
+		err := ForkJoinError[string]{Errors: map[string]error{
+			"billing": fmt.Errorf("billing: %w", ErrTimeout),
+			"codec":   UnmarshalError{Data: []byte("{"), Err: errors.New("unexpected EOF")},
+		}}
+		require.ErrorIs(t, err, ErrTimeout)
+		require.NotErrorIs(t, err, ErrEmpty)
+		var unmarshalErr UnmarshalError
+		require.ErrorAs(t, err, &unmarshalErr)
+		require.Equal(t, []byte("{"), unmarshalErr.Data)
+		var panicErr PanicError
+		require.False(t, errors.As(err, &panicErr))
+	})
+}
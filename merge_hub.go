@@ -0,0 +1,244 @@
+package rxgo
+
+import (
+	"sync"
+)
+
+// MergeHub merges the Observables attached at runtime into a single Observable. Unlike `MergeWith` or `MergeMap`, the set of sources isn't fixed at subscription time: sources can join and leave at any moment, and the output only completes once the hub is closed.
+type MergeHub[T any] interface {
+	// Add attaches the source to the hub, every current and future subscriber of the hub subscribes to it. The returned handle detaches the source. Adding a source to a closed hub has no effect.
+	Add(source Observable[T]) MergeHandle
+	// Close detaches every source and completes the output of the hub.
+	Close()
+	// Len returns the number of attached sources. A source is detached from the hub once it has completed or failed, so the later subscribers don't subscribe to it.
+	Len() int
+	AsObservable() Observable[T]
+}
+
+// MergeHandle detaches a source from a `MergeHub`.
+type MergeHandle interface {
+	// Remove unsubscribes every subscriber of the hub from the source, it's safe to call it more than once.
+	Remove()
+}
+
+// Creates a MergeHub. With `StopOnError` (the default), an error emitted by a source is forwarded to the subscribers, which terminate. With `ContinueOnError`, a failing source is detached and the error is dropped.
+func NewMergeHub[T any](strategy ...OnErrorStrategy) MergeHub[T] {
+	hub := &mergeHub[T]{
+		sources:     make(map[uint64]Observable[T]),
+		subscribers: make(map[*mergeHubSubscription[T]]struct{}),
+		closeCh:     make(chan struct{}),
+	}
+	if len(strategy) > 0 {
+		hub.strategy = strategy[0]
+	}
+	return hub
+}
+
+type mergeHub[T any] struct {
+	mu          sync.Mutex
+	strategy    OnErrorStrategy
+	nextID      uint64
+	sources     map[uint64]Observable[T]
+	subscribers map[*mergeHubSubscription[T]]struct{}
+	closed      bool
+	closeCh     chan struct{}
+}
+
+var _ MergeHub[any] = (*mergeHub[any])(nil)
+
+func (h *mergeHub[T]) Add(source Observable[T]) MergeHandle {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.closed {
+		return &mergeHandle{remove: func() {}}
+	}
+
+	id := h.nextID
+	h.nextID++
+	h.sources[id] = source
+	for sub := range h.subscribers {
+		sub.attach(id, source)
+	}
+
+	once := new(sync.Once)
+	return &mergeHandle{remove: func() {
+		once.Do(func() {
+			h.remove(id)
+		})
+	}}
+}
+
+func (h *mergeHub[T]) remove(id uint64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.sources, id)
+	for sub := range h.subscribers {
+		sub.detach(id)
+	}
+}
+
+// forget detaches the source which terminated from the hub, the subscribers
+// still subscribed to it are left running until it terminates for them too.
+func (h *mergeHub[T]) forget(id uint64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.sources, id)
+}
+
+func (h *mergeHub[T]) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.closed {
+		return
+	}
+	h.closed = true
+	h.sources = make(map[uint64]Observable[T])
+	close(h.closeCh)
+}
+
+func (h *mergeHub[T]) Len() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.sources)
+}
+
+func (h *mergeHub[T]) AsObservable() Observable[T] {
+	return newObservable(func(subscriber Subscriber[T]) {
+		var (
+			sub = &mergeHubSubscription[T]{
+				hub:        h,
+				strategy:   h.strategy,
+				subscriber: subscriber,
+				streams:    make(map[uint64]Subscriber[T]),
+				errCh:      make(chan error, 1),
+			}
+		)
+
+		h.mu.Lock()
+		if h.closed {
+			h.mu.Unlock()
+			Complete[T]().Send(subscriber)
+			return
+		}
+		h.subscribers[sub] = struct{}{}
+		for id, source := range h.sources {
+			sub.attach(id, source)
+		}
+		h.mu.Unlock()
+
+		var (
+			err error
+		)
+
+		select {
+		case <-subscriber.Closed():
+		case <-h.closeCh:
+		case err = <-sub.errCh:
+		}
+
+		h.mu.Lock()
+		delete(h.subscribers, sub)
+		h.mu.Unlock()
+
+		sub.stop()
+
+		if err != nil {
+			Error[T](err).Send(subscriber)
+			return
+		}
+
+		Complete[T]().Send(subscriber)
+	})
+}
+
+type mergeHandle struct {
+	remove func()
+}
+
+func (m *mergeHandle) Remove() {
+	m.remove()
+}
+
+// mergeHubSubscription holds the inner subscriptions of a single subscriber of the hub.
+type mergeHubSubscription[T any] struct {
+	mu         sync.Mutex
+	wg         sync.WaitGroup
+	hub        *mergeHub[T]
+	strategy   OnErrorStrategy
+	subscriber Subscriber[T]
+	streams    map[uint64]Subscriber[T]
+	stopped    bool
+	errCh      chan error
+}
+
+func (s *mergeHubSubscription[T]) attach(id uint64, source Observable[T]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stopped {
+		return
+	}
+
+	s.wg.Add(2)
+	stream := source.SubscribeOn(s.wg.Done)
+	s.streams[id] = stream
+	go s.observe(id, stream)
+}
+
+func (s *mergeHubSubscription[T]) detach(id uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if stream, ok := s.streams[id]; ok {
+		delete(s.streams, id)
+		stream.Stop()
+	}
+}
+
+func (s *mergeHubSubscription[T]) observe(id uint64, stream Subscriber[T]) {
+	defer s.wg.Done()
+
+	for {
+		select {
+		case <-s.subscriber.Closed():
+			stream.Stop()
+			return
+
+		case item, ok := <-stream.ForEach():
+			if !ok {
+				return
+			}
+
+			if err := item.Err(); err != nil {
+				s.detach(id)
+				s.hub.forget(id)
+				if s.strategy == StopOnError {
+					select {
+					case s.errCh <- err:
+					default:
+					}
+				}
+				return
+			}
+
+			if item.Done() {
+				s.detach(id)
+				s.hub.forget(id)
+				return
+			}
+
+			item.Send(s.subscriber)
+		}
+	}
+}
+
+// stop unsubscribes from every source and waits for the inner subscriptions to exit.
+func (s *mergeHubSubscription[T]) stop() {
+	s.mu.Lock()
+	s.stopped = true
+	for id, stream := range s.streams {
+		delete(s.streams, id)
+		stream.Stop()
+	}
+	s.mu.Unlock()
+
+	s.wg.Wait()
+}
@@ -0,0 +1,141 @@
+package rxgo
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeHub(t *testing.T) {
+	t.Run("MergeHub closed without source", func(t *testing.T) {
+		hub := NewMergeHub[uint]()
+		go func() {
+			time.Sleep(time.Millisecond * 10)
+			hub.Close()
+		}()
+		checkObservableResults(t, hub.AsObservable(), []uint{}, nil, true)
+	})
+
+	t.Run("MergeHub subscribe after Close", func(t *testing.T) {
+		hub := NewMergeHub[uint]()
+		hub.Close()
+		hub.Close()
+		hub.Add(Of2[uint](1)).Remove()
+		require.Equal(t, 0, hub.Len())
+		checkObservableResults(t, hub.AsObservable(), []uint{}, nil, true)
+	})
+
+	t.Run("MergeHub with completed sources", func(t *testing.T) {
+		hub := NewMergeHub[string]()
+		hub.Add(Of2("a", "b"))
+		go func() {
+			time.Sleep(time.Millisecond * 20)
+			hub.Add(Of2("c"))
+			time.Sleep(time.Millisecond * 20)
+			hub.Close()
+		}()
+		checkObservableResults(t, hub.AsObservable(), []string{"a", "b", "c"}, nil, true)
+	})
+
+	t.Run("MergeHub with Add and Remove", func(t *testing.T) {
+		var (
+			hub    = NewMergeHub[uint]()
+			result []uint
+			mu     = new(sync.Mutex)
+			done   = make(chan struct{})
+			values = make(chan struct{}, 10)
+		)
+
+		go hub.AsObservable().SubscribeSync(func(v uint) {
+			mu.Lock()
+			result = append(result, v)
+			mu.Unlock()
+			values <- struct{}{}
+		}, nil, func() {
+			close(done)
+		})
+
+		time.Sleep(time.Millisecond * 10)
+		handle := hub.Add(Pipe1(Interval(time.Millisecond), Take[uint](100)))
+		require.Equal(t, 1, hub.Len())
+		<-values
+		<-values
+		handle.Remove()
+		handle.Remove()
+		require.Equal(t, 0, hub.Len())
+
+		mu.Lock()
+		count := len(result)
+		mu.Unlock()
+		time.Sleep(time.Millisecond * 20)
+		mu.Lock()
+		// at most one value could be in flight while removing the source
+		require.LessOrEqual(t, len(result), count+1)
+		mu.Unlock()
+
+		hub.Close()
+		<-done
+	})
+
+	t.Run("MergeHub with error", func(t *testing.T) {
+		var err = errors.New("failed")
+		hub := NewMergeHub[uint]()
+		hub.Add(Pipe1(Interval(time.Millisecond*100), Take[uint](3)))
+		hub.Add(Throw[uint](func() error {
+			return err
+		}))
+		checkObservableResults(t, hub.AsObservable(), []uint{}, err, false)
+		hub.Close()
+	})
+
+	t.Run("MergeHub with ContinueOnError", func(t *testing.T) {
+		var err = errors.New("failed")
+		hub := NewMergeHub[uint](ContinueOnError)
+		hub.Add(Throw[uint](func() error {
+			return err
+		}))
+		hub.Add(Range[uint](1, 3))
+		go func() {
+			time.Sleep(time.Millisecond * 20)
+			hub.Close()
+		}()
+		checkObservableResults(t, hub.AsObservable(), []uint{1, 2, 3}, nil, true)
+	})
+
+	t.Run("MergeHub should detach completed sources", func(t *testing.T) {
+		var (
+			hub    = NewMergeHub[uint]()
+			length = make(chan int, 1)
+		)
+		hub.Add(Of2[uint](1, 2))
+		require.Equal(t, 1, hub.Len())
+		go func() {
+			time.Sleep(time.Millisecond * 50)
+			length <- hub.Len()
+			hub.Close()
+		}()
+		checkObservableResults(t, hub.AsObservable(), []uint{1, 2}, nil, true)
+		require.Equal(t, 0, <-length)
+	})
+
+	t.Run("MergeHub should not resubscribe to failed sources", func(t *testing.T) {
+		var err = errors.New("failed")
+		hub := NewMergeHub[uint]()
+		hub.Add(Throw[uint](func() error {
+			return err
+		}))
+		checkObservableResults(t, hub.AsObservable(), []uint{}, err, false)
+		require.Equal(t, 0, hub.Len())
+
+		go func() {
+			time.Sleep(time.Millisecond * 10)
+			hub.Add(Of2[uint](3))
+			time.Sleep(time.Millisecond * 20)
+			hub.Close()
+		}()
+		checkObservableResults(t, hub.AsObservable(), []uint{3}, nil, true)
+	})
+}
@@ -0,0 +1,186 @@
+package rxgo
+
+import (
+	"sort"
+	"sync"
+)
+
+// PrioritizedSource is a source of `MergePriority` and `MergePriorityFair`, a higher `Priority` means the values of the source are preferred.
+type PrioritizedSource[T any] struct {
+	Source   Observable[T]
+	Priority uint
+}
+
+// Creates an output Observable which concurrently emits all values from every given input Observable. Whenever more than one source has a value available, the value of the source with the highest priority is emitted first, so a busy high priority source may starve the others. Sources sharing the same priority are drained in the given order.
+func MergePriority[T any](sources ...PrioritizedSource[T]) Observable[T] {
+	return mergePriority(sources, false)
+}
+
+// Same as `MergePriority`, but uses a weighted fair scheduling to avoid starvation: when several sources have a value available, every source gets a share of the emissions proportional to its `Priority` + 1.
+func MergePriorityFair[T any](sources ...PrioritizedSource[T]) Observable[T] {
+	return mergePriority(sources, true)
+}
+
+func mergePriority[T any](sources []PrioritizedSource[T], fair bool) Observable[T] {
+	inputs := make([]Observable[T], len(sources))
+	for i, src := range sources {
+		inputs[i] = src.Source
+	}
+	return linkGraph(newObservable(func(subscriber Subscriber[T]) {
+		noOfSources := len(sources)
+		if noOfSources == 0 {
+			Complete[T]().Send(subscriber)
+			return
+		}
+
+		type prioritizedStream struct {
+			priority uint
+			weight   int
+			current  int
+			stream   Subscriber[T]
+			pending  chan Notification[T]
+			done     bool
+		}
+
+		var (
+			wg      = new(sync.WaitGroup)
+			stopCh  = make(chan struct{})
+			readyCh = make(chan struct{}, 1)
+			streams = make([]*prioritizedStream, noOfSources)
+		)
+
+		wg.Add(noOfSources * 2)
+
+		for i, src := range sources {
+			streams[i] = &prioritizedStream{
+				priority: src.Priority,
+				weight:   int(src.Priority) + 1,
+				stream:   src.Source.SubscribeOn(wg.Done),
+				pending:  make(chan Notification[T], 1),
+			}
+		}
+
+		// the highest priority goes first, the order is kept for the same priority
+		sort.SliceStable(streams, func(i, j int) bool {
+			return streams[i].priority > streams[j].priority
+		})
+
+		for _, s := range streams {
+			go forwardPending(s.stream, s.pending, readyCh, stopCh, wg.Done)
+		}
+
+		// next returns the stream to emit from, or nil if no value is available
+		next := func() *prioritizedStream {
+			if !fair {
+				for _, s := range streams {
+					if !s.done && len(s.pending) > 0 {
+						return s
+					}
+				}
+				return nil
+			}
+
+			// smooth weighted round-robin amongst the available streams
+			var (
+				selected *prioritizedStream
+				total    int
+			)
+			for _, s := range streams {
+				if s.done || len(s.pending) == 0 {
+					continue
+				}
+				s.current += s.weight
+				total += s.weight
+				if selected == nil || s.current > selected.current {
+					selected = s
+				}
+			}
+			if selected != nil {
+				selected.current -= total
+			}
+			return selected
+		}
+
+		var (
+			activeCount = noOfSources
+			exception   error
+		)
+
+	loop:
+		for activeCount > 0 {
+			s := next()
+			if s == nil {
+				select {
+				case <-subscriber.Closed():
+					break loop
+				case <-readyCh:
+				}
+				continue
+			}
+
+			item := <-s.pending
+			if err := item.Err(); err != nil {
+				exception = err
+				break loop
+			}
+
+			if item.Done() {
+				s.done = true
+				activeCount--
+				continue
+			}
+
+			if !item.Send(subscriber) {
+				break loop
+			}
+		}
+
+		close(stopCh)
+		wg.Wait()
+
+		if exception != nil {
+			Error[T](exception).Send(subscriber)
+			return
+		}
+
+		if activeCount == 0 {
+			Complete[T]().Send(subscriber)
+		}
+	}), inputs...)
+}
+
+// forwardPending forwards the stream to its own pending slot, so the merger knows
+// which streams have a value available without consuming them. The stream closing
+// without notification is forwarded as a completion.
+func forwardPending[T any](stream Subscriber[T], pending chan<- Notification[T], readyCh chan<- struct{}, stopCh <-chan struct{}, done func()) {
+	defer done()
+
+	for {
+		select {
+		case <-stopCh:
+			stream.Stop()
+			return
+
+		case item, ok := <-stream.ForEach():
+			if !ok {
+				item = Complete[T]()
+			}
+
+			select {
+			case <-stopCh:
+				stream.Stop()
+				return
+			case pending <- item:
+			}
+
+			select {
+			case readyCh <- struct{}{}:
+			default:
+			}
+
+			if item.IsEnd() {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package rxgo
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergePriority(t *testing.T) {
+	t.Run("MergePriority without sources", func(t *testing.T) {
+		checkObservableResults(t, MergePriority[uint](), []uint{}, nil, true)
+	})
+
+	t.Run("MergePriority with Empty", func(t *testing.T) {
+		checkObservableResults(t, MergePriority(
+			PrioritizedSource[uint]{Source: Empty[uint](), Priority: 1},
+			PrioritizedSource[uint]{Source: Empty[uint]()},
+		), []uint{}, nil, true)
+	})
+
+	t.Run("MergePriority with error", func(t *testing.T) {
+		var err = errors.New("failed")
+		checkObservableResults(t, MergePriority(
+			PrioritizedSource[uint]{Source: Pipe1(Interval(time.Millisecond*100), Take[uint](3))},
+			PrioritizedSource[uint]{Source: Throw[uint](func() error {
+				return err
+			})},
+		), []uint{}, err, false)
+	})
+
+	t.Run("MergePriority with values", func(t *testing.T) {
+		checkObservableHasResults(t, MergePriority(
+			PrioritizedSource[uint]{Source: Range[uint](1, 10)},
+			PrioritizedSource[uint]{Source: Range[uint](100, 10), Priority: 1},
+		), true, nil, true)
+	})
+
+	t.Run("MergePriority drains the higher priority first", func(t *testing.T) {
+		var (
+			high = []uint{1, 2, 3, 4, 5}
+			// sent[n] is closed once the high priority source has sent n notifications
+			sent   = make([]chan struct{}, len(high)+2)
+			result []uint
+		)
+		for i := range sent {
+			sent[i] = make(chan struct{})
+		}
+		waitSent := func(n int) {
+			select {
+			case <-sent[n]:
+			case <-time.After(time.Second):
+			}
+		}
+
+		highSource := newObservable(func(subscriber Subscriber[uint]) {
+			for i, v := range high {
+				if !Next(v).Send(subscriber) {
+					return
+				}
+				close(sent[i+1])
+			}
+			if Complete[uint]().Send(subscriber) {
+				close(sent[len(high)+1])
+			}
+		})
+		// the notification n+1 is only accepted once the value n is pending, the low
+		// priority source waits for the first two high priority values to be pending
+		lowSource := newObservable(func(subscriber Subscriber[uint]) {
+			waitSent(3)
+			for _, v := range []uint{100, 101, 102, 103, 104} {
+				if !Next(v).Send(subscriber) {
+					return
+				}
+			}
+			Complete[uint]().Send(subscriber)
+		})
+
+		MergePriority(
+			PrioritizedSource[uint]{Source: lowSource},
+			PrioritizedSource[uint]{Source: highSource, Priority: 5},
+		).SubscribeSync(func(v uint) {
+			result = append(result, v)
+			// the merger picks the value after next while this one is consumed, so it
+			// holds the consumer until the high priority value after next is pending
+			if n := len(result) + 3; n < len(sent) {
+				waitSent(n)
+			}
+		}, nil, nil)
+
+		require.Equal(t, []uint{1, 2, 3, 4, 5, 100, 101, 102, 103, 104}, result)
+	})
+
+	t.Run("MergePriorityFair shares the emissions", func(t *testing.T) {
+		var result []uint
+		MergePriorityFair(
+			PrioritizedSource[uint]{Source: Range[uint](100, 20)},
+			PrioritizedSource[uint]{Source: Range[uint](1, 20), Priority: 2},
+		).SubscribeSync(func(v uint) {
+			result = append(result, v)
+			time.Sleep(time.Millisecond * 2)
+		}, nil, nil)
+
+		require.Len(t, result, 40)
+		var low int
+		for _, v := range result[:20] {
+			if v >= 100 {
+				low++
+			}
+		}
+		// the low priority source gets about a quarter of the emissions
+		require.GreaterOrEqual(t, low, 3)
+		require.LessOrEqual(t, low, 7)
+	})
+}
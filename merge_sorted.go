@@ -0,0 +1,174 @@
+package rxgo
+
+import (
+	"sync"
+	"time"
+)
+
+// Merges the given sorted Observables into a single sorted Observable. A value is only emitted once every active source has a value available (or has completed), the smallest of them according to `less` is emitted first. The output is only sorted if every source is sorted.
+func MergeSorted[T any](less func(a, b T) bool, sources ...Observable[T]) Observable[T] {
+	return MergeSortedWithTimeout(less, 0, sources...)
+}
+
+// Same as `MergeSorted`, but bounds the lookahead: if some active source has no value available for the duration of `timeout`, the available values are emitted, smallest first, without waiting for it until every active source has a value available again. A late value may then be emitted out of order. A zero `timeout` waits forever.
+func MergeSortedWithTimeout[T any](less func(a, b T) bool, timeout time.Duration, sources ...Observable[T]) Observable[T] {
+	if less == nil {
+		panic(`rxgo: "MergeSorted" expected less func`)
+	}
+	return linkGraph(newObservable(func(subscriber Subscriber[T]) {
+		noOfSources := len(sources)
+		if noOfSources == 0 {
+			Complete[T]().Send(subscriber)
+			return
+		}
+
+		type sortedStream struct {
+			pending chan Notification[T]
+			head    T
+			hasHead bool
+			done    bool
+		}
+
+		var (
+			wg      = new(sync.WaitGroup)
+			stopCh  = make(chan struct{})
+			readyCh = make(chan struct{}, 1)
+			streams = make([]*sortedStream, noOfSources)
+		)
+
+		wg.Add(noOfSources * 2)
+
+		for i, src := range sources {
+			s := &sortedStream{pending: make(chan Notification[T], 1)}
+			streams[i] = s
+			go forwardPending(src.SubscribeOn(wg.Done), s.pending, readyCh, stopCh, wg.Done)
+		}
+
+		var (
+			activeCount = noOfSources
+			exception   error
+			timer       *time.Timer
+			// expired is set once the lookahead has timed out, until every
+			// active source has a value available again
+			expired bool
+		)
+
+		// fill takes the values available, it returns false if some active
+		// source has no value yet
+		fill := func() bool {
+			complete := true
+			for _, s := range streams {
+				if s.done || s.hasHead {
+					continue
+				}
+
+				select {
+				case item := <-s.pending:
+					if err := item.Err(); err != nil {
+						exception = err
+						return false
+					}
+
+					if item.Done() {
+						s.done = true
+						activeCount--
+						continue
+					}
+
+					s.head, s.hasHead = item.Value(), true
+				default:
+					complete = false
+				}
+			}
+			return complete
+		}
+
+		// emitMin emits the smallest head, it returns false if there is no head
+		emitMin := func() bool {
+			var selected *sortedStream
+			for _, s := range streams {
+				if s.hasHead && (selected == nil || less(s.head, selected.head)) {
+					selected = s
+				}
+			}
+			if selected == nil {
+				return false
+			}
+			value := selected.head
+			selected.head, selected.hasHead = *new(T), false
+			return Next(value).Send(subscriber)
+		}
+
+		hasHead := func() bool {
+			for _, s := range streams {
+				if s.hasHead {
+					return true
+				}
+			}
+			return false
+		}
+
+	loop:
+		for {
+			complete := fill()
+			if exception != nil {
+				break loop
+			}
+
+			if complete {
+				expired = false
+				if activeCount == 0 && !hasHead() {
+					break loop
+				}
+				if !emitMin() {
+					break loop
+				}
+				continue
+			}
+
+			if expired && hasHead() {
+				if !emitMin() {
+					break loop
+				}
+				continue
+			}
+
+			var timeoutCh <-chan time.Time
+			if timeout > 0 && !expired && hasHead() {
+				timer = time.NewTimer(timeout)
+				timeoutCh = timer.C
+			}
+
+			select {
+			case <-subscriber.Closed():
+				break loop
+
+			case <-readyCh:
+
+			case <-timeoutCh:
+				expired = true
+			}
+
+			if timer != nil {
+				timer.Stop()
+				timer = nil
+			}
+		}
+
+		if timer != nil {
+			timer.Stop()
+		}
+
+		close(stopCh)
+		wg.Wait()
+
+		if exception != nil {
+			Error[T](exception).Send(subscriber)
+			return
+		}
+
+		if activeCount == 0 && !hasHead() {
+			Complete[T]().Send(subscriber)
+		}
+	}), sources...)
+}
@@ -0,0 +1,95 @@
+package rxgo
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeSorted(t *testing.T) {
+	less := func(a, b uint) bool {
+		return a < b
+	}
+
+	t.Run("MergeSorted without sources", func(t *testing.T) {
+		checkObservableResults(t, MergeSorted(less), []uint{}, nil, true)
+	})
+
+	t.Run("MergeSorted with Empty", func(t *testing.T) {
+		checkObservableResults(t, MergeSorted(less, Empty[uint](), Empty[uint]()), []uint{}, nil, true)
+	})
+
+	t.Run("MergeSorted with error", func(t *testing.T) {
+		var err = errors.New("failed")
+		checkObservableResults(t, MergeSorted(less,
+			Of2[uint](1, 2, 3),
+			Throw[uint](func() error {
+				return err
+			}),
+		), []uint{}, err, false)
+	})
+
+	t.Run("MergeSorted with values", func(t *testing.T) {
+		checkObservableResults(t, MergeSorted(less,
+			Of2[uint](1, 4, 7, 10),
+			Of2[uint](2, 5, 8),
+			Of2[uint](3, 6, 9, 11, 12),
+		), []uint{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}, nil, true)
+	})
+
+	t.Run("MergeSorted with slow source", func(t *testing.T) {
+		checkObservableResults(t, MergeSorted(less,
+			Of2[uint](1, 3, 5),
+			Pipe2(Interval(time.Millisecond*10), Take[uint](3), Map(func(v, _ uint) (uint, error) {
+				return v * 2, nil
+			})),
+		), []uint{0, 1, 2, 3, 4, 5}, nil, true)
+	})
+
+	t.Run("MergeSorted with sources completing at different times", func(t *testing.T) {
+		checkObservableResults(t, MergeSorted(less,
+			Of2[uint](1),
+			Pipe1(Interval(time.Millisecond*5), Take[uint](4)),
+			Empty[uint](),
+		), []uint{0, 1, 1, 2, 3}, nil, true)
+	})
+
+	t.Run("MergeSortedWithTimeout with lagging source", func(t *testing.T) {
+		checkObservableResults(t, MergeSortedWithTimeout(less, time.Millisecond*20,
+			Of2[uint](5, 6),
+			Pipe1(Timer[uint](time.Millisecond*200), Map(func(uint, uint) (uint, error) {
+				return 1, nil
+			})),
+		), []uint{5, 6, 1}, nil, true)
+	})
+
+	t.Run("MergeSortedWithTimeout should not wait for the lagging source after the timeout", func(t *testing.T) {
+		var (
+			start   = time.Now()
+			elapsed time.Duration
+		)
+		checkObservableResults(t, Pipe1(
+			MergeSortedWithTimeout(less, time.Millisecond*50,
+				Range[uint](1, 10),
+				Pipe1(Timer[uint](time.Millisecond*300), Map(func(uint, uint) (uint, error) {
+					return 100, nil
+				})),
+			),
+			TapEach(func(v, _ uint) {
+				if v == 10 {
+					elapsed = time.Since(start)
+				}
+			}),
+		), []uint{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 100}, nil, true)
+		// a single timeout releases every available value
+		require.Less(t, elapsed, time.Millisecond*200)
+	})
+
+	t.Run("MergeSorted without less", func(t *testing.T) {
+		require.Panics(t, func() {
+			MergeSorted[uint](nil)
+		})
+	})
+}
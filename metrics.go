@@ -0,0 +1,332 @@
+package rxgo
+
+import (
+	"expvar"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Metrics receives the measures of the instrumented stages of a pipeline, see `Instrument` and `EnableInstrumentation`. The methods are called concurrently, from the goroutines of the stages, they must be cheap.
+type Metrics interface {
+	// ItemIn is called when the stage receives an item.
+	ItemIn(stage string)
+	// ItemOut is called when the stage emits an item.
+	ItemOut(stage string)
+	// Error is called when the stage emits an error.
+	Error(stage string)
+	// Complete is called when the stage completes.
+	Complete(stage string)
+	// Latency is called with the time spent by the stage on an item, see `Instrument` and `EnableInstrumentation` for what it covers.
+	Latency(stage string, latency time.Duration)
+	// QueueDepth is called with the number of items queued by the stage, waiting to be processed, whenever it changes. Only the stages holding a queue report it, such as `MergeMapWithConfig` with a concurrency limit.
+	QueueDepth(stage string, depth int64)
+}
+
+type metricsHolder struct {
+	metrics Metrics
+}
+
+var (
+	defaultMetrics = NewInMemoryMetrics()
+	globalMetrics  atomic.Pointer[metricsHolder]
+	instrumented   atomic.Bool
+)
+
+// SetMetrics replaces the exporter receiving the measures of the instrumented stages, it's an in-memory exporter by default, see `DefaultMetrics`.
+func SetMetrics(metrics Metrics) {
+	globalMetrics.Store(&metricsHolder{metrics})
+}
+
+// ResetMetrics restores the default in-memory exporter.
+func ResetMetrics() {
+	globalMetrics.Store(nil)
+}
+
+// GetMetrics returns the exporter receiving the measures of the instrumented stages.
+func GetMetrics() Metrics {
+	if holder := globalMetrics.Load(); holder != nil {
+		return holder.metrics
+	}
+	return defaultMetrics
+}
+
+// DefaultMetrics returns the default in-memory exporter.
+func DefaultMetrics() *InMemoryMetrics {
+	return defaultMetrics
+}
+
+// EnableInstrumentation enables the global instrumentation: every Observable and operator created afterwards is a stage, named after the operator and where it was created, such as "Map (main.go:12)". Every stage reports the items it emits, its error or completion. The items received and the latency, the time taken by the user function on every item, are only reported by the operators calling a function per item, such as `Map`, `Filter`, `Scan` or `MapCtx`, and by `MergeMapWithConfig` (and the operators built on it) for which the latency lasts until the inner Observable terminates and the queue depth is reported. The other operators, such as `Spy`, `Register` or the join operators, don't. Capturing the names has a cost, prefer `Instrument` to measure a few stages in production.
+func EnableInstrumentation() {
+	instrumented.Store(true)
+}
+
+// DisableInstrumentation disables the global instrumentation.
+func DisableInstrumentation() {
+	instrumented.Store(false)
+}
+
+// Instrument mirrors the source Observable and measures it as the stage `name`: the items received from the source and delivered downstream, its error or completion, and as latency the time taken by the downstream to accept every item, that is how long the downstream holds back the source. It doesn't process the items, so it doesn't queue any and reports no queue depth. The measures are sent to the exporter set by `SetMetrics` at the time of the subscription.
+func Instrument[T any](name string) OperatorFunc[T, T] {
+	return func(source Observable[T]) Observable[T] {
+		return newObservable(func(subscriber Subscriber[T]) {
+			var (
+				wg    = new(sync.WaitGroup)
+				stage = &stageState{name: name, metrics: GetMetrics()}
+			)
+
+			wg.Add(1)
+
+			var (
+				upStream = source.SubscribeOn(wg.Done)
+			)
+
+		loop:
+			for {
+				select {
+				case <-subscriber.Closed():
+					upStream.Stop()
+					break loop
+
+				case item, ok := <-upStream.ForEach():
+					if !ok {
+						break loop
+					}
+
+					if item.IsEnd() {
+						stage.terminate(item.Err())
+						item.Send(subscriber)
+						break loop
+					}
+
+					start := stage.begin()
+					sent := item.Send(subscriber)
+					if sent {
+						stage.metrics.ItemOut(name)
+					}
+					stage.end(start)
+					if !sent {
+						upStream.Stop()
+						break loop
+					}
+				}
+			}
+
+			wg.Wait()
+		})
+	}
+}
+
+// stageState measures a stage of a pipeline.
+type stageState struct {
+	name    string
+	metrics Metrics
+}
+
+// stageTracker is implemented by the subscribers created with the global
+// instrumentation.
+type stageTracker interface {
+	stageState() *stageState
+}
+
+// newStageName returns the name of the stage created by the caller, it returns
+// an empty string unless the global instrumentation is enabled.
+func newStageName(skip int) string {
+	if !instrumented.Load() {
+		return ""
+	}
+	operator, location, _ := callSite(skip+1, 32)
+	if location == "" {
+		return operator
+	}
+	return fmt.Sprintf("%s (%s)", operator, filepath.Base(location))
+}
+
+// stageOf returns the stage measured by the subscriber, if any.
+func stageOf[T any](subscriber Subscriber[T]) *stageState {
+	if t, ok := subscriber.(stageTracker); ok {
+		return t.stageState()
+	}
+	return nil
+}
+
+// begin must be called when the stage receives an item.
+func (s *stageState) begin() time.Time {
+	s.metrics.ItemIn(s.name)
+	return time.Now()
+}
+
+// end must be called when the stage is done processing an item.
+func (s *stageState) end(start time.Time) {
+	s.metrics.Latency(s.name, time.Since(start))
+}
+
+// queue must be called when the queue of the stage changes.
+func (s *stageState) queue(depth int) {
+	s.metrics.QueueDepth(s.name, int64(depth))
+}
+
+func (s *stageState) terminate(err error) {
+	if err != nil {
+		s.metrics.Error(s.name)
+	} else {
+		s.metrics.Complete(s.name)
+	}
+}
+
+// LatencyBuckets are the upper bounds of the latency histograms of `InMemoryMetrics`.
+var LatencyBuckets = []time.Duration{
+	time.Microsecond,
+	10 * time.Microsecond,
+	100 * time.Microsecond,
+	time.Millisecond,
+	10 * time.Millisecond,
+	100 * time.Millisecond,
+	time.Second,
+	10 * time.Second,
+}
+
+// Histogram counts the latencies by bucket, `Counts[i]` is the number of latencies lower than or equal to `Buckets[i]`, the last count holds the latencies above every bucket.
+type Histogram struct {
+	Buckets []time.Duration
+	Counts  []uint64
+	Count   uint64
+	Sum     time.Duration
+}
+
+// Mean returns the mean latency.
+func (h Histogram) Mean() time.Duration {
+	if h.Count == 0 {
+		return 0
+	}
+	return h.Sum / time.Duration(h.Count)
+}
+
+// StageStats are the measures of a stage, see `InMemoryMetrics`.
+type StageStats struct {
+	ItemsIn     uint64
+	ItemsOut    uint64
+	Errors      uint64
+	Completions uint64
+	QueueDepth  int64
+	Latency     Histogram
+}
+
+// InMemoryMetrics is an exporter keeping the measures of every stage in memory, it's safe for concurrent use.
+type InMemoryMetrics struct {
+	mu     sync.Mutex
+	stages map[string]*StageStats
+}
+
+var _ Metrics = (*InMemoryMetrics)(nil)
+
+// NewInMemoryMetrics creates an empty in-memory exporter.
+func NewInMemoryMetrics() *InMemoryMetrics {
+	return &InMemoryMetrics{stages: make(map[string]*StageStats)}
+}
+
+// stage must be called with the lock held.
+func (m *InMemoryMetrics) stage(name string) *StageStats {
+	s, ok := m.stages[name]
+	if !ok {
+		s = &StageStats{Latency: Histogram{
+			Buckets: LatencyBuckets,
+			Counts:  make([]uint64, len(LatencyBuckets)+1),
+		}}
+		m.stages[name] = s
+	}
+	return s
+}
+
+func (m *InMemoryMetrics) ItemIn(stage string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stage(stage).ItemsIn++
+}
+
+func (m *InMemoryMetrics) ItemOut(stage string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stage(stage).ItemsOut++
+}
+
+func (m *InMemoryMetrics) Error(stage string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stage(stage).Errors++
+}
+
+func (m *InMemoryMetrics) Complete(stage string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stage(stage).Completions++
+}
+
+func (m *InMemoryMetrics) Latency(stage string, latency time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	h := &m.stage(stage).Latency
+	i := 0
+	for i < len(h.Buckets) && latency > h.Buckets[i] {
+		i++
+	}
+	h.Counts[i]++
+	h.Count++
+	h.Sum += latency
+}
+
+func (m *InMemoryMetrics) QueueDepth(stage string, depth int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stage(stage).QueueDepth = depth
+}
+
+// Stage returns the measures of the stage, they are zero if the stage is unknown.
+func (m *InMemoryMetrics) Stage(name string) StageStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if s, ok := m.stages[name]; ok {
+		return s.clone()
+	}
+	return StageStats{}
+}
+
+// Snapshot returns the measures of every stage by name.
+func (m *InMemoryMetrics) Snapshot() map[string]StageStats {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	snapshot := make(map[string]StageStats, len(m.stages))
+	for name, s := range m.stages {
+		snapshot[name] = s.clone()
+	}
+	return snapshot
+}
+
+// Reset forgets every stage.
+func (m *InMemoryMetrics) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stages = make(map[string]*StageStats)
+}
+
+func (s *StageStats) clone() StageStats {
+	c := *s
+	c.Latency.Counts = append([]uint64(nil), s.Latency.Counts...)
+	return c
+}
+
+// ExpvarMetrics is an in-memory exporter which also publishes the measures of every stage as an `expvar` variable, served as JSON by the "/debug/vars" handler.
+type ExpvarMetrics struct {
+	*InMemoryMetrics
+}
+
+// NewExpvarMetrics creates an exporter published under the given `expvar` name. Like `expvar.Publish`, it panics if the name is already in use.
+func NewExpvarMetrics(name string) *ExpvarMetrics {
+	m := &ExpvarMetrics{NewInMemoryMetrics()}
+	expvar.Publish(name, expvar.Func(func() any {
+		return m.Snapshot()
+	}))
+	return m
+}
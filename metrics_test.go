@@ -0,0 +1,227 @@
+package rxgo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstrument(t *testing.T) {
+	t.Run("Instrument with values", func(t *testing.T) {
+		metrics := NewInMemoryMetrics()
+		SetMetrics(metrics)
+		t.Cleanup(ResetMetrics)
+
+		checkObservableResults(t, Pipe2(
+			Range[uint](1, 5),
+			Instrument[uint]("source"),
+			Filter(func(v uint, _ uint) bool {
+				return v%2 == 0
+			}),
+		), []uint{2, 4}, nil, true)
+
+		stats := metrics.Stage("source")
+		require.Equal(t, uint64(5), stats.ItemsIn)
+		require.Equal(t, uint64(5), stats.ItemsOut)
+		require.Equal(t, uint64(0), stats.Errors)
+		require.Equal(t, uint64(1), stats.Completions)
+		require.Equal(t, int64(0), stats.QueueDepth)
+		require.Equal(t, uint64(5), stats.Latency.Count)
+	})
+
+	t.Run("Instrument with error", func(t *testing.T) {
+		metrics := NewInMemoryMetrics()
+		SetMetrics(metrics)
+		t.Cleanup(ResetMetrics)
+
+		var err = errors.New("failed")
+		checkObservableResults(t, Pipe1(
+			Throw[string](func() error {
+				return err
+			}),
+			Instrument[string]("throw"),
+		), []string{}, err, false)
+
+		stats := metrics.Stage("throw")
+		require.Equal(t, uint64(0), stats.ItemsIn)
+		require.Equal(t, uint64(1), stats.Errors)
+		require.Equal(t, uint64(0), stats.Completions)
+	})
+
+	t.Run("Instrument with slow consumer", func(t *testing.T) {
+		metrics := NewInMemoryMetrics()
+		SetMetrics(metrics)
+		t.Cleanup(ResetMetrics)
+
+		Pipe1(Of2(1, 2, 3), Instrument[int]("slow")).SubscribeSync(func(int) {
+			time.Sleep(time.Millisecond * 5)
+		}, nil, nil)
+
+		stats := metrics.Stage("slow")
+		require.Equal(t, uint64(3), stats.Latency.Count)
+		require.GreaterOrEqual(t, stats.Latency.Mean(), time.Millisecond)
+	})
+
+	t.Run("Instrument with the default metrics", func(t *testing.T) {
+		DefaultMetrics().Reset()
+		t.Cleanup(DefaultMetrics().Reset)
+
+		checkObservableResults(t, Pipe1(Of2("a", "b"), Instrument[string]("default")), []string{"a", "b"}, nil, true)
+		require.Equal(t, uint64(2), DefaultMetrics().Stage("default").ItemsOut)
+	})
+}
+
+func TestInstrumentation(t *testing.T) {
+	t.Run("EnableInstrumentation", func(t *testing.T) {
+		metrics := NewInMemoryMetrics()
+		SetMetrics(metrics)
+		EnableInstrumentation()
+		t.Cleanup(func() {
+			DisableInstrumentation()
+			ResetMetrics()
+		})
+
+		checkObservableResults(t, Pipe2(
+			Range[uint](1, 5),
+			Map(func(v uint, _ uint) (uint, error) {
+				return v * 2, nil
+			}),
+			Filter(func(v uint, _ uint) bool {
+				return v > 4
+			}),
+		), []uint{6, 8, 10}, nil, true)
+
+		var mapStats, filterStats StageStats
+		for name, stats := range metrics.Snapshot() {
+			require.Contains(t, name, "metrics_test.go")
+			switch {
+			case strings.HasPrefix(name, "Map "):
+				mapStats = stats
+			case strings.HasPrefix(name, "Filter "):
+				filterStats = stats
+			}
+		}
+
+		require.Equal(t, uint64(5), mapStats.ItemsIn)
+		require.Equal(t, uint64(5), mapStats.ItemsOut)
+		require.Equal(t, uint64(5), mapStats.Latency.Count)
+		require.Equal(t, uint64(1), mapStats.Completions)
+		require.Equal(t, uint64(5), filterStats.ItemsIn)
+		require.Equal(t, uint64(3), filterStats.ItemsOut)
+	})
+
+	t.Run("EnableInstrumentation with MapCtx and MergeMapWithConfig", func(t *testing.T) {
+		metrics := &maxDepthMetrics{InMemoryMetrics: NewInMemoryMetrics()}
+		SetMetrics(metrics)
+		EnableInstrumentation()
+		t.Cleanup(func() {
+			DisableInstrumentation()
+			ResetMetrics()
+		})
+
+		checkObservableResults(t, Pipe2(
+			Range[uint](1, 5),
+			MapCtx(func(_ context.Context, v uint, _ uint) (uint, error) {
+				return v, nil
+			}),
+			MergeMapWithConfig(func(v uint, _ uint) Observable[uint] {
+				return Pipe1(Timer[uint](time.Millisecond*5), Map(func(uint, uint) (uint, error) {
+					return v, nil
+				}))
+			}, MergeConfig{Concurrent: 1}),
+		), []uint{1, 2, 3, 4, 5}, nil, true)
+
+		var mapStats, mergeStats StageStats
+		for name, stats := range metrics.Snapshot() {
+			switch {
+			case strings.HasPrefix(name, "MapCtx "):
+				mapStats = stats
+			case strings.HasPrefix(name, "MergeMapWithConfig "):
+				mergeStats = stats
+			}
+		}
+
+		require.Equal(t, uint64(5), mapStats.ItemsIn)
+		require.Equal(t, uint64(5), mapStats.Latency.Count)
+		require.Equal(t, uint64(5), mergeStats.ItemsIn)
+		require.Equal(t, uint64(5), mergeStats.ItemsOut)
+		// the latency of MergeMap lasts until the inner Observable terminates
+		require.Equal(t, uint64(5), mergeStats.Latency.Count)
+		require.GreaterOrEqual(t, mergeStats.Latency.Mean(), time.Millisecond*5)
+		// the values wait for the single slot
+		require.Greater(t, metrics.maxDepth(), int64(1))
+		require.Equal(t, int64(0), mergeStats.QueueDepth)
+	})
+
+	t.Run("DisableInstrumentation", func(t *testing.T) {
+		metrics := NewInMemoryMetrics()
+		SetMetrics(metrics)
+		t.Cleanup(ResetMetrics)
+
+		checkObservableResults(t, Pipe1(Of2(1, 2), Map(func(v int, _ uint) (int, error) {
+			return v, nil
+		})), []int{1, 2}, nil, true)
+		require.Empty(t, metrics.Snapshot())
+	})
+}
+
+// maxDepthMetrics records the maximum queue depth.
+type maxDepthMetrics struct {
+	*InMemoryMetrics
+	mu  sync.Mutex
+	max int64
+}
+
+func (m *maxDepthMetrics) QueueDepth(stage string, depth int64) {
+	m.mu.Lock()
+	if depth > m.max {
+		m.max = depth
+	}
+	m.mu.Unlock()
+	m.InMemoryMetrics.QueueDepth(stage, depth)
+}
+
+func (m *maxDepthMetrics) maxDepth() int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.max
+}
+
+func TestInMemoryMetrics(t *testing.T) {
+	metrics := NewInMemoryMetrics()
+	metrics.Latency("stage", time.Microsecond/2)
+	metrics.Latency("stage", time.Millisecond*5)
+	metrics.Latency("stage", time.Minute)
+
+	h := metrics.Stage("stage").Latency
+	require.Equal(t, uint64(3), h.Count)
+	require.Equal(t, uint64(1), h.Counts[0])
+	require.Equal(t, uint64(1), h.Counts[4])
+	require.Equal(t, uint64(1), h.Counts[len(h.Counts)-1])
+	require.Equal(t, StageStats{}, metrics.Stage("unknown"))
+
+	metrics.Reset()
+	require.Empty(t, metrics.Snapshot())
+}
+
+func TestExpvarMetrics(t *testing.T) {
+	metrics := NewExpvarMetrics("rxgo_test_metrics")
+	metrics.ItemIn("stage")
+	metrics.ItemOut("stage")
+
+	var snapshot map[string]StageStats
+	require.NoError(t, json.Unmarshal([]byte(expvar.Get("rxgo_test_metrics").String()), &snapshot))
+	require.Equal(t, uint64(1), snapshot["stage"].ItemsIn)
+	require.Equal(t, uint64(1), snapshot["stage"].ItemsOut)
+
+	require.Panics(t, func() {
+		NewExpvarMetrics("rxgo_test_metrics")
+	})
+}
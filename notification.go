@@ -55,15 +55,89 @@ func (d notification[T]) IsEnd() bool {
 	return d.err != nil || d.done
 }
 
+// trackedSubscriber is implemented by the subscribers created by RxGo, they
+// enforce the `Next* (Error|Complete)?` grammar and carry the debug and
+// instrumentation states.
+type trackedSubscriber interface {
+	acceptNotification(end bool) bool
+	operatorState() *operatorState
+	stageState() *stageState
+}
+
+// Send delivers the notification to the subscriber, it returns false if the subscriber is closed or if it has already received a terminal notification, in which case the notification is dropped. An error which can't be delivered is reported to the `OnUndeliverableError` hook. Only the notifications delivered with Send are checked against the grammar, not the ones written directly to the `Subscriber.Send()` channel.
 func (d *notification[T]) Send(sub Subscriber[T]) bool {
+	return d.send(sub, nil)
+}
+
+// sendUntil delivers the notification as `Send` does, but gives up once done is
+// closed. If done is already closed, the notification is only delivered if the
+// subscriber is ready to receive it.
+func sendUntil[T any](n Notification[T], sub Subscriber[T], done <-chan struct{}) bool {
+	if d, ok := n.(*notification[T]); ok {
+		return d.send(sub, done)
+	}
+	return n.Send(sub)
+}
+
+func (d *notification[T]) send(sub Subscriber[T], done <-chan struct{}) bool {
+	var stage *stageState
+	if t, ok := sub.(trackedSubscriber); ok {
+		if !t.acceptNotification(d.IsEnd()) {
+			d.undeliverable()
+			return false
+		}
+
+		if s := t.operatorState(); s != nil {
+			if d.err != nil {
+				d = &notification[T]{kind: d.kind, err: s.wrap(d.err)}
+			} else if d.kind == NextKind {
+				s.next()
+			}
+		}
+
+		if stage = t.stageState(); stage != nil && d.IsEnd() {
+			stage.terminate(d.err)
+		}
+	}
+
+	select {
+	case <-done:
+		select {
+		case sub.Send() <- d:
+			d.delivered(stage)
+			return true
+		default:
+			d.undeliverable()
+			return false
+		}
+	default:
+	}
+
 	select {
 	case <-sub.Closed():
+		d.undeliverable()
+		return false
+	case <-done:
+		d.undeliverable()
 		return false
 	case sub.Send() <- d:
+		d.delivered(stage)
 		return true
 	}
 }
 
+func (d *notification[T]) delivered(stage *stageState) {
+	if stage != nil && d.kind == NextKind {
+		stage.metrics.ItemOut(stage.name)
+	}
+}
+
+func (d *notification[T]) undeliverable() {
+	if d.err != nil {
+		onUndeliverableError(d.err)
+	}
+}
+
 func Next[T any](v T) Notification[T] {
 	return &notification[T]{kind: NextKind, v: v}
 }
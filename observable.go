@@ -189,6 +189,63 @@ func Timer[N constraints.Unsigned](startDue time.Duration, intervalDuration ...t
 	})
 }
 
+// Creates an Observable that uses a resource which lives as long as the subscription. On subscribe, the resource is created by `resourceFactory`, handed to `observableFactory`, and disposed exactly once when the stream completes, errors or the subscriber unsubscribes. If the resource can't be created, the error is emitted and nothing is disposed.
+func Using[T any, R any](resourceFactory func() (R, error), observableFactory func(resource R) Observable[T], dispose func(resource R)) Observable[T] {
+	if resourceFactory == nil {
+		panic(`rxgo: "Using" expected resourceFactory func`)
+	}
+	if observableFactory == nil {
+		panic(`rxgo: "Using" expected observableFactory func`)
+	}
+	return newObservable(func(subscriber Subscriber[T]) {
+		resource, err := resourceFactory()
+		if err != nil {
+			Error[T](err).Send(subscriber)
+			return
+		}
+
+		if dispose != nil {
+			defer dispose(resource)
+		}
+
+		var (
+			wg     = new(sync.WaitGroup)
+			stream = observableFactory(resource)
+		)
+
+		if stream == nil {
+			stream = Empty[T]()
+		}
+
+		wg.Add(1)
+
+		var (
+			upStream = stream.SubscribeOn(wg.Done)
+		)
+
+	loop:
+		for {
+			select {
+			case <-subscriber.Closed():
+				upStream.Stop()
+				break loop
+
+			case item, ok := <-upStream.ForEach():
+				if !ok {
+					break loop
+				}
+
+				item.Send(subscriber)
+				if item.IsEnd() {
+					break loop
+				}
+			}
+		}
+
+		wg.Wait()
+	})
+}
+
 // Checks a boolean at subscription time, and chooses between one of two observable sources
 func Iif[T any](condition func() bool, trueObservable Observable[T], falseObservable Observable[T]) Observable[T] {
 	return newObservable(func(subscriber Subscriber[T]) {
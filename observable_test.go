@@ -3,9 +3,11 @@ package rxgo
 import (
 	"errors"
 	"fmt"
+	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/require"
 	"go.uber.org/goleak"
 )
 
@@ -143,3 +145,62 @@ func TestIif(t *testing.T) {
 		checkObservableResults(t, iif, []any{"a"}, err, false)
 	})
 }
+
+func TestUsing(t *testing.T) {
+	type resource struct {
+		disposed int32
+	}
+
+	t.Run("Using with values", func(t *testing.T) {
+		res := new(resource)
+		checkObservableResults(t, Using(func() (*resource, error) {
+			return res, nil
+		}, func(r *resource) Observable[uint] {
+			return Range[uint](1, 3)
+		}, func(r *resource) {
+			atomic.AddInt32(&r.disposed, 1)
+		}), []uint{1, 2, 3}, nil, true)
+		require.Equal(t, int32(1), atomic.LoadInt32(&res.disposed))
+	})
+
+	t.Run("Using with error", func(t *testing.T) {
+		var (
+			err = errors.New("failed")
+			res = new(resource)
+		)
+		checkObservableResults(t, Using(func() (*resource, error) {
+			return res, nil
+		}, func(r *resource) Observable[any] {
+			return Scheduled[any](1, err)
+		}, func(r *resource) {
+			atomic.AddInt32(&r.disposed, 1)
+		}), []any{1}, err, false)
+		require.Equal(t, int32(1), atomic.LoadInt32(&res.disposed))
+	})
+
+	t.Run("Using with resource error", func(t *testing.T) {
+		var err = errors.New("cannot open")
+		checkObservableResults(t, Using(func() (*resource, error) {
+			return nil, err
+		}, func(r *resource) Observable[uint] {
+			t.Fatal("observableFactory should not be called")
+			return nil
+		}, func(r *resource) {
+			t.Fatal("dispose should not be called")
+		}), []uint{}, err, false)
+	})
+
+	t.Run("Using with unsubscribe", func(t *testing.T) {
+		res := new(resource)
+		checkObservableResults(t, Pipe1(Using(func() (*resource, error) {
+			return res, nil
+		}, func(r *resource) Observable[uint] {
+			return Interval(time.Millisecond)
+		}, func(r *resource) {
+			atomic.AddInt32(&r.disposed, 1)
+		}), Take[uint](2)), []uint{0, 1}, nil, true)
+		require.Eventually(t, func() bool {
+			return atomic.LoadInt32(&res.disposed) == 1
+		}, time.Second, time.Millisecond)
+	})
+}
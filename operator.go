@@ -126,6 +126,180 @@ func Do[T any](cb Observer[T]) OperatorFunc[T, T] {
 	}
 }
 
+// Returns an Observable that mirrors the source Observable, but calls the callback exactly once when the stream completes, errors or the subscriber unsubscribes. Unlike `Do`, the callback is also called when the subscriber stops early.
+func Finalize[T any](callback FinalizerFunc) OperatorFunc[T, T] {
+	if callback == nil {
+		panic(`rxgo: "Finalize" expected callback func`)
+	}
+	return func(source Observable[T]) Observable[T] {
+		return newObservable(func(subscriber Subscriber[T]) {
+			defer callback()
+
+			var (
+				wg = new(sync.WaitGroup)
+			)
+
+			wg.Add(1)
+
+			var (
+				upStream = source.SubscribeOn(wg.Done)
+			)
+
+		loop:
+			for {
+				select {
+				case <-subscriber.Closed():
+					upStream.Stop()
+					break loop
+
+				case item, ok := <-upStream.ForEach():
+					if !ok {
+						break loop
+					}
+
+					item.Send(subscriber)
+					if item.IsEnd() {
+						break loop
+					}
+				}
+			}
+
+			wg.Wait()
+		})
+	}
+}
+
+// Used to perform side-effects when the source Observable is subscribed to, before the subscription reaches the source.
+func TapOnSubscribe[T any](callback func()) OperatorFunc[T, T] {
+	if callback == nil {
+		panic(`rxgo: "TapOnSubscribe" expected callback func`)
+	}
+	return func(source Observable[T]) Observable[T] {
+		return tap(source, tapHooks[T]{onSubscribe: callback})
+	}
+}
+
+// Used to perform side-effects when the subscriber unsubscribes before the source Observable terminates. The callback isn't called when the source completes or errors.
+func TapOnUnsubscribe[T any](callback func()) OperatorFunc[T, T] {
+	if callback == nil {
+		panic(`rxgo: "TapOnUnsubscribe" expected callback func`)
+	}
+	return func(source Observable[T]) Observable[T] {
+		return tap(source, tapHooks[T]{onUnsubscribe: callback})
+	}
+}
+
+// Used to perform side-effects when the source Observable completes or errors, the callback is called before the notification is forwarded.
+func TapOnTerminate[T any](callback func()) OperatorFunc[T, T] {
+	if callback == nil {
+		panic(`rxgo: "TapOnTerminate" expected callback func`)
+	}
+	return func(source Observable[T]) Observable[T] {
+		return tap(source, tapHooks[T]{onTerminate: callback})
+	}
+}
+
+// Used to perform side-effects for every notification of the source Observable, the callback is called before the notification is forwarded.
+func TapNotification[T any](callback func(ObservableNotification[T])) OperatorFunc[T, T] {
+	if callback == nil {
+		panic(`rxgo: "TapNotification" expected callback func`)
+	}
+	return func(source Observable[T]) Observable[T] {
+		return tap(source, tapHooks[T]{onNotification: callback})
+	}
+}
+
+// Used to perform side-effects for every value of the source Observable along with its index, the index is reset on every subscription.
+func TapEach[T any](callback func(value T, index uint)) OperatorFunc[T, T] {
+	if callback == nil {
+		panic(`rxgo: "TapEach" expected callback func`)
+	}
+	return func(source Observable[T]) Observable[T] {
+		return tap(source, tapHooks[T]{onEach: callback})
+	}
+}
+
+type tapHooks[T any] struct {
+	onSubscribe    func()
+	onUnsubscribe  func()
+	onTerminate    func()
+	onNotification func(ObservableNotification[T])
+	onEach         func(T, uint)
+}
+
+// tap mirrors the source and calls the hooks, unlike `createOperatorFunc` the
+// state is bound to the subscription.
+func tap[T any](source Observable[T], hooks tapHooks[T]) Observable[T] {
+	return newObservable(func(subscriber Subscriber[T]) {
+		if hooks.onSubscribe != nil {
+			hooks.onSubscribe()
+		}
+
+		var (
+			wg = new(sync.WaitGroup)
+		)
+
+		wg.Add(1)
+
+		var (
+			index        uint
+			upStream     = source.SubscribeOn(wg.Done)
+			unsubscribed bool
+		)
+
+	loop:
+		for {
+			select {
+			case <-subscriber.Closed():
+				upStream.Stop()
+				unsubscribed = true
+				break loop
+
+			case item, ok := <-upStream.ForEach():
+				if !ok {
+					break loop
+				}
+
+				// a panic in a hook stops the upstream as any error would
+				if err := catchPanic(func() {
+					if hooks.onNotification != nil {
+						hooks.onNotification(item)
+					}
+					if item.IsEnd() {
+						if hooks.onTerminate != nil {
+							hooks.onTerminate()
+						}
+					} else if hooks.onEach != nil {
+						hooks.onEach(item.Value(), index)
+					}
+				}); err != nil {
+					upStream.Stop()
+					Error[T](err).Send(subscriber)
+					break loop
+				}
+
+				if item.IsEnd() {
+					item.Send(subscriber)
+					break loop
+				}
+				index++
+
+				if !item.Send(subscriber) {
+					upStream.Stop()
+					unsubscribed = true
+					break loop
+				}
+			}
+		}
+
+		wg.Wait()
+
+		if unsubscribed && hooks.onUnsubscribe != nil {
+			hooks.onUnsubscribe()
+		}
+	})
+}
+
 // Delays the emission of items from the source Observable by a given timeout.
 func Delay[T any](duration time.Duration) OperatorFunc[T, T] {
 	return func(source Observable[T]) Observable[T] {
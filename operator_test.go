@@ -3,6 +3,7 @@ package rxgo
 import (
 	"errors"
 	"fmt"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -168,3 +169,151 @@ func TestToSlice(t *testing.T) {
 		}), ToSlice[string]()), []string{"A", "B", "C", "D", "E"}, nil, true)
 	})
 }
+
+func TestFinalize(t *testing.T) {
+	t.Run("Finalize with Empty", func(t *testing.T) {
+		var count int32
+		checkObservableResults(t, Pipe1(Empty[uint](), Finalize[uint](func() {
+			atomic.AddInt32(&count, 1)
+		})), []uint{}, nil, true)
+		require.Equal(t, int32(1), atomic.LoadInt32(&count))
+	})
+
+	t.Run("Finalize with error", func(t *testing.T) {
+		var (
+			err   = errors.New("failed")
+			count int32
+		)
+		checkObservableResults(t, Pipe1(Scheduled[any]("a", err), Finalize[any](func() {
+			atomic.AddInt32(&count, 1)
+		})), []any{"a"}, err, false)
+		require.Equal(t, int32(1), atomic.LoadInt32(&count))
+	})
+
+	t.Run("Finalize with unsubscribe", func(t *testing.T) {
+		var count int32
+		checkObservableResults(t, Pipe2(
+			Interval(time.Millisecond),
+			Finalize[uint](func() {
+				atomic.AddInt32(&count, 1)
+			}),
+			Take[uint](3),
+		), []uint{0, 1, 2}, nil, true)
+		require.Eventually(t, func() bool {
+			return atomic.LoadInt32(&count) == 1
+		}, time.Second, time.Millisecond)
+		time.Sleep(time.Millisecond * 10)
+		require.Equal(t, int32(1), atomic.LoadInt32(&count))
+	})
+
+	t.Run("Finalize on every subscription", func(t *testing.T) {
+		var count int32
+		obs := Pipe1(Of2(1, 2), Finalize[int](func() {
+			atomic.AddInt32(&count, 1)
+		}))
+		checkObservableResults(t, obs, []int{1, 2}, nil, true)
+		checkObservableResults(t, obs, []int{1, 2}, nil, true)
+		require.Equal(t, int32(2), atomic.LoadInt32(&count))
+	})
+}
+
+func TestTapOnSubscribe(t *testing.T) {
+	t.Run("TapOnSubscribe on every subscription", func(t *testing.T) {
+		var count int32
+		obs := Pipe1(Of2(1, 2), TapOnSubscribe[int](func() {
+			atomic.AddInt32(&count, 1)
+		}))
+		checkObservableResults(t, obs, []int{1, 2}, nil, true)
+		checkObservableResults(t, obs, []int{1, 2}, nil, true)
+		require.Equal(t, int32(2), atomic.LoadInt32(&count))
+	})
+}
+
+func TestTapOnUnsubscribe(t *testing.T) {
+	t.Run("TapOnUnsubscribe with complete", func(t *testing.T) {
+		var count int32
+		checkObservableResults(t, Pipe1(Of2(1, 2), TapOnUnsubscribe[int](func() {
+			atomic.AddInt32(&count, 1)
+		})), []int{1, 2}, nil, true)
+		require.Equal(t, int32(0), atomic.LoadInt32(&count))
+	})
+
+	t.Run("TapOnUnsubscribe with unsubscribe", func(t *testing.T) {
+		var count int32
+		checkObservableResults(t, Pipe2(
+			Interval(time.Millisecond),
+			TapOnUnsubscribe[uint](func() {
+				atomic.AddInt32(&count, 1)
+			}),
+			Take[uint](2),
+		), []uint{0, 1}, nil, true)
+		require.Eventually(t, func() bool {
+			return atomic.LoadInt32(&count) == 1
+		}, time.Second, time.Millisecond)
+	})
+}
+
+func TestTapOnTerminate(t *testing.T) {
+	t.Run("TapOnTerminate with complete", func(t *testing.T) {
+		var count int32
+		checkObservableResults(t, Pipe1(Of2(1, 2), TapOnTerminate[int](func() {
+			atomic.AddInt32(&count, 1)
+		})), []int{1, 2}, nil, true)
+		require.Equal(t, int32(1), atomic.LoadInt32(&count))
+	})
+
+	t.Run("TapOnTerminate with error", func(t *testing.T) {
+		var (
+			err   = errors.New("failed")
+			count int32
+		)
+		checkObservableResults(t, Pipe1(Scheduled[any](1, err), TapOnTerminate[any](func() {
+			atomic.AddInt32(&count, 1)
+		})), []any{1}, err, false)
+		require.Equal(t, int32(1), atomic.LoadInt32(&count))
+	})
+
+	t.Run("TapOnTerminate with unsubscribe", func(t *testing.T) {
+		var count int32
+		checkObservableResults(t, Pipe2(
+			Interval(time.Millisecond),
+			TapOnTerminate[uint](func() {
+				atomic.AddInt32(&count, 1)
+			}),
+			Take[uint](2),
+		), []uint{0, 1}, nil, true)
+		time.Sleep(time.Millisecond * 10)
+		require.Equal(t, int32(0), atomic.LoadInt32(&count))
+	})
+}
+
+func TestTapNotification(t *testing.T) {
+	t.Run("TapNotification with values", func(t *testing.T) {
+		var kinds []NotificationKind
+		checkObservableResults(t, Pipe1(Of2("a", "b"), TapNotification(func(n ObservableNotification[string]) {
+			kinds = append(kinds, n.Kind())
+		})), []string{"a", "b"}, nil, true)
+		require.Equal(t, []NotificationKind{NextKind, NextKind, CompleteKind}, kinds)
+	})
+
+	t.Run("TapNotification with error", func(t *testing.T) {
+		var (
+			err  = errors.New("failed")
+			errs []error
+		)
+		checkObservableResults(t, Pipe1(Scheduled[any]("a", err), TapNotification(func(n ObservableNotification[any]) {
+			errs = append(errs, n.Err())
+		})), []any{"a"}, err, false)
+		require.Equal(t, []error{nil, err}, errs)
+	})
+
+	t.Run("TapEach with index", func(t *testing.T) {
+		var result []string
+		obs := Pipe1(Of2("a", "b"), TapEach(func(v string, i uint) {
+			result = append(result, fmt.Sprintf("%d:%s", i, v))
+		}))
+		checkObservableResults(t, obs, []string{"a", "b"}, nil, true)
+		checkObservableResults(t, obs, []string{"a", "b"}, nil, true)
+		require.Equal(t, []string{"0:a", "1:b", "0:a", "1:b"}, result)
+	})
+}
@@ -8,9 +8,9 @@ func Pipe[S any, O1 any](
 	f1 OperatorFunc[S, any],
 	f ...OperatorFunc[any, any],
 ) Observable[any] {
-	result := f1(stream)
+	result := pipe(stream, f1)
 	for _, cb := range f {
-		result = cb(result)
+		result = pipe(result, cb)
 	}
 	return result
 }
@@ -19,7 +19,7 @@ func Pipe1[S any, O1 any](
 	stream Observable[S],
 	f1 OperatorFunc[S, O1],
 ) Observable[O1] {
-	return f1(stream)
+	return pipe(stream, f1)
 }
 
 func Pipe2[S any, O1 any, O2 any](
@@ -27,7 +27,7 @@ func Pipe2[S any, O1 any, O2 any](
 	f1 OperatorFunc[S, O1],
 	f2 OperatorFunc[O1, O2],
 ) Observable[O2] {
-	return f2(f1(stream))
+	return pipe(pipe(stream, f1), f2)
 }
 
 func Pipe3[S any, O1 any, O2 any, O3 any](
@@ -36,7 +36,7 @@ func Pipe3[S any, O1 any, O2 any, O3 any](
 	f2 OperatorFunc[O1, O2],
 	f3 OperatorFunc[O2, O3],
 ) Observable[O3] {
-	return f3(f2(f1(stream)))
+	return pipe(pipe(pipe(stream, f1), f2), f3)
 }
 
 func Pipe4[S any, O1 any, O2 any, O3 any, O4 any](
@@ -46,7 +46,7 @@ func Pipe4[S any, O1 any, O2 any, O3 any, O4 any](
 	f3 OperatorFunc[O2, O3],
 	f4 OperatorFunc[O3, O4],
 ) Observable[O4] {
-	return f4(f3(f2(f1(stream))))
+	return pipe(pipe(pipe(pipe(stream, f1), f2), f3), f4)
 }
 
 func Pipe5[S any, O1 any, O2 any, O3 any, O4 any, O5 any](
@@ -57,7 +57,7 @@ func Pipe5[S any, O1 any, O2 any, O3 any, O4 any, O5 any](
 	f4 OperatorFunc[O3, O4],
 	f5 OperatorFunc[O4, O5],
 ) Observable[O5] {
-	return f5(f4(f3(f2(f1(stream)))))
+	return pipe(pipe(pipe(pipe(pipe(stream, f1), f2), f3), f4), f5)
 }
 
 func Pipe6[S any, O1 any, O2 any, O3 any, O4 any, O5 any, O6 any](
@@ -69,7 +69,7 @@ func Pipe6[S any, O1 any, O2 any, O3 any, O4 any, O5 any, O6 any](
 	f5 OperatorFunc[O4, O5],
 	f6 OperatorFunc[O5, O6],
 ) Observable[O6] {
-	return f6(f5(f4(f3(f2(f1(stream))))))
+	return pipe(pipe(pipe(pipe(pipe(pipe(stream, f1), f2), f3), f4), f5), f6)
 }
 
 func Pipe7[S any, O1 any, O2 any, O3 any, O4 any, O5 any, O6 any, O7 any](
@@ -82,7 +82,7 @@ func Pipe7[S any, O1 any, O2 any, O3 any, O4 any, O5 any, O6 any, O7 any](
 	f6 OperatorFunc[O5, O6],
 	f7 OperatorFunc[O6, O7],
 ) Observable[O7] {
-	return f7(f6(f5(f4(f3(f2(f1(stream)))))))
+	return pipe(pipe(pipe(pipe(pipe(pipe(pipe(stream, f1), f2), f3), f4), f5), f6), f7)
 }
 
 func Pipe8[S any, O1 any, O2 any, O3 any, O4 any, O5 any, O6 any, O7 any, O8 any](
@@ -96,7 +96,7 @@ func Pipe8[S any, O1 any, O2 any, O3 any, O4 any, O5 any, O6 any, O7 any, O8 any
 	f7 OperatorFunc[O6, O7],
 	f8 OperatorFunc[O7, O8],
 ) Observable[O8] {
-	return f8(f7(f6(f5(f4(f3(f2(f1(stream))))))))
+	return pipe(pipe(pipe(pipe(pipe(pipe(pipe(pipe(stream, f1), f2), f3), f4), f5), f6), f7), f8)
 }
 
 func Pipe9[S any, O1 any, O2 any, O3 any, O4 any, O5 any, O6 any, O7 any, O8 any, O9 any](
@@ -111,7 +111,7 @@ func Pipe9[S any, O1 any, O2 any, O3 any, O4 any, O5 any, O6 any, O7 any, O8 any
 	f8 OperatorFunc[O7, O8],
 	f9 OperatorFunc[O8, O9],
 ) Observable[O9] {
-	return f9(f8(f7(f6(f5(f4(f3(f2(f1(stream)))))))))
+	return pipe(pipe(pipe(pipe(pipe(pipe(pipe(pipe(pipe(stream, f1), f2), f3), f4), f5), f6), f7), f8), f9)
 }
 
 func Pipe10[S any, O1 any, O2 any, O3 any, O4 any, O5 any, O6 any, O7 any, O8 any, O9 any, O10 any](
@@ -127,5 +127,10 @@ func Pipe10[S any, O1 any, O2 any, O3 any, O4 any, O5 any, O6 any, O7 any, O8 an
 	f9 OperatorFunc[O8, O9],
 	f10 OperatorFunc[O9, O10],
 ) Observable[O10] {
-	return f10(f9(f8(f7(f6(f5(f4(f3(f2(f1(stream))))))))))
+	return pipe(pipe(pipe(pipe(pipe(pipe(pipe(pipe(pipe(pipe(stream, f1), f2), f3), f4), f5), f6), f7), f8), f9), f10)
+}
+
+// pipe applies the operator, linking the stages of the pipeline graph.
+func pipe[I any, O any](stream Observable[I], f OperatorFunc[I, O]) Observable[O] {
+	return linkGraph(f(stream), stream)
 }
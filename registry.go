@@ -0,0 +1,232 @@
+package rxgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"runtime"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"text/tabwriter"
+	"time"
+)
+
+// SubscriptionState is the state of a subscription tracked by a `Registry`.
+type SubscriptionState string
+
+const (
+	// SubscriptionActive means the subscription is waiting for a value from the source.
+	SubscriptionActive SubscriptionState = "active"
+	// SubscriptionBlocked means the subscription is waiting for the downstream to accept a value.
+	SubscriptionBlocked SubscriptionState = "blocked"
+)
+
+// SubscriptionInfo describes a subscription tracked by a `Registry`.
+type SubscriptionInfo struct {
+	ID       uint64            `json:"id"`
+	Pipeline string            `json:"pipeline"`
+	Start    time.Time         `json:"start"`
+	Items    uint64            `json:"items"`
+	State    SubscriptionState `json:"state"`
+}
+
+// Registry tracks the active subscriptions of the pipelines registered with `Register`, it's safe for concurrent use. It's also an `http.Handler` dumping the subscriptions, see `ServeHTTP`.
+type Registry struct {
+	mu     sync.Mutex
+	nextID uint64
+	subs   map[uint64]*registeredSubscription
+}
+
+type registeredSubscription struct {
+	id       uint64
+	pipeline string
+	start    time.Time
+	items    atomic.Uint64
+	blocked  atomic.Bool
+	cancel   chan struct{}
+	once     sync.Once
+}
+
+var defaultRegistry = NewRegistry()
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{subs: make(map[uint64]*registeredSubscription)}
+}
+
+// DefaultRegistry returns the Registry used by `Register` when none is given.
+func DefaultRegistry() *Registry {
+	return defaultRegistry
+}
+
+// Mirrors the source Observable and tracks its subscriptions in the registry under the pipeline name, until they terminate or unsubscribe. A subscription cancelled from the registry stops its source and emits `ErrCancelled`, if it's blocked on a downstream which doesn't accept the pending value, the value is dropped first. The default registry is used if none is given.
+func Register[T any](pipeline string, registry ...*Registry) OperatorFunc[T, T] {
+	r := defaultRegistry
+	if len(registry) > 0 && registry[0] != nil {
+		r = registry[0]
+	}
+	return func(source Observable[T]) Observable[T] {
+		return newObservable(func(subscriber Subscriber[T]) {
+			var (
+				wg  = new(sync.WaitGroup)
+				sub = r.add(pipeline)
+			)
+
+			defer r.remove(sub.id)
+
+			wg.Add(1)
+
+			var (
+				upStream = source.SubscribeOn(wg.Done)
+			)
+
+		loop:
+			for {
+				select {
+				case <-subscriber.Closed():
+					upStream.Stop()
+					break loop
+
+				case <-sub.cancel:
+					upStream.Stop()
+					Error[T](ErrCancelled).Send(subscriber)
+					break loop
+
+				case item, ok := <-upStream.ForEach():
+					if !ok {
+						break loop
+					}
+
+					sub.blocked.Store(true)
+					sent := sendUntil(item, subscriber, sub.cancel)
+					sub.blocked.Store(false)
+					if !sent {
+						upStream.Stop()
+						// cancelled while blocked, the pending value is dropped
+						if sub.cancelled() {
+							Error[T](ErrCancelled).Send(subscriber)
+						}
+						break loop
+					}
+
+					if item.IsEnd() {
+						break loop
+					}
+
+					sub.items.Add(1)
+				}
+			}
+
+			wg.Wait()
+		})
+	}
+}
+
+func (s *registeredSubscription) cancelled() bool {
+	select {
+	case <-s.cancel:
+		return true
+	default:
+		return false
+	}
+}
+
+func (r *Registry) add(pipeline string) *registeredSubscription {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.nextID++
+	sub := &registeredSubscription{
+		id:       r.nextID,
+		pipeline: pipeline,
+		start:    time.Now(),
+		cancel:   make(chan struct{}),
+	}
+	r.subs[sub.id] = sub
+	return sub
+}
+
+func (r *Registry) remove(id uint64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.subs, id)
+}
+
+// Subscriptions returns the active subscriptions, ordered by ID.
+func (r *Registry) Subscriptions() []SubscriptionInfo {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	infos := make([]SubscriptionInfo, 0, len(r.subs))
+	for _, sub := range r.subs {
+		info := SubscriptionInfo{
+			ID:       sub.id,
+			Pipeline: sub.pipeline,
+			Start:    sub.start,
+			Items:    sub.items.Load(),
+			State:    SubscriptionActive,
+		}
+		if sub.blocked.Load() {
+			info.State = SubscriptionBlocked
+		}
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].ID < infos[j].ID
+	})
+	return infos
+}
+
+// Cancel cancels the subscription, it returns false if no such subscription is active.
+func (r *Registry) Cancel(id uint64) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	sub, ok := r.subs[id]
+	if ok {
+		sub.once.Do(func() {
+			close(sub.cancel)
+		})
+	}
+	return ok
+}
+
+// ServeHTTP dumps the active subscriptions on GET, along with the number of goroutines of the whole process (not only of the registered pipelines), as JSON if the "format" query parameter is "json" or as text otherwise. It cancels the subscription given by the "id" query parameter on DELETE.
+func (r *Registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	switch req.Method {
+	case http.MethodGet:
+		subs := r.Subscriptions()
+		if req.URL.Query().Get("format") == "json" {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(struct {
+				ProcessGoroutines int                `json:"processGoroutines"`
+				Subscriptions     []SubscriptionInfo `json:"subscriptions"`
+			}{runtime.NumGoroutine(), subs})
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		fmt.Fprintf(w, "process goroutines: %d\n\n", runtime.NumGoroutine())
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "ID\tPIPELINE\tSTATE\tITEMS\tUPTIME")
+		for _, sub := range subs {
+			fmt.Fprintf(tw, "%d\t%s\t%s\t%d\t%s\n", sub.ID, sub.Pipeline, sub.State, sub.Items, time.Since(sub.Start).Round(time.Millisecond))
+		}
+		tw.Flush()
+
+	case http.MethodDelete:
+		id, err := strconv.ParseUint(req.URL.Query().Get("id"), 10, 64)
+		if err != nil {
+			http.Error(w, "rxgo: invalid subscription id", http.StatusBadRequest)
+			return
+		}
+		if !r.Cancel(id) {
+			http.Error(w, "rxgo: no such subscription", http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		w.Header().Set("Allow", "GET, DELETE")
+		http.Error(w, "rxgo: method not allowed", http.StatusMethodNotAllowed)
+	}
+}
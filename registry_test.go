@@ -0,0 +1,197 @@
+package rxgo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+)
+
+func TestRegister(t *testing.T) {
+	t.Run("Register removes the terminated subscriptions", func(t *testing.T) {
+		registry := NewRegistry()
+		checkObservableResults(t, Pipe1(Of2(1, 2, 3), Register[int]("numbers", registry)), []int{1, 2, 3}, nil, true)
+		require.Empty(t, registry.Subscriptions())
+	})
+
+	t.Run("Register tracks the active subscriptions", func(t *testing.T) {
+		registry := NewRegistry()
+		sub := Pipe1(Interval(time.Millisecond), Register[uint]("ticks", registry)).SubscribeOn()
+		defer sub.Stop()
+
+		for i := 0; i < 3; i++ {
+			<-sub.ForEach()
+		}
+
+		subs := registry.Subscriptions()
+		require.Len(t, subs, 1)
+		require.Equal(t, uint64(1), subs[0].ID)
+		require.Equal(t, "ticks", subs[0].Pipeline)
+		require.GreaterOrEqual(t, subs[0].Items, uint64(2))
+		require.False(t, subs[0].Start.IsZero())
+
+		// nobody reads the values anymore
+		require.Eventually(t, func() bool {
+			return registry.Subscriptions()[0].State == SubscriptionBlocked
+		}, time.Second, time.Millisecond)
+
+		sub.Stop()
+		require.Eventually(t, func() bool {
+			return len(registry.Subscriptions()) == 0
+		}, time.Second, time.Millisecond)
+	})
+
+	t.Run("Register with Cancel", func(t *testing.T) {
+		registry := NewRegistry()
+		obs := Pipe1(Interval(time.Hour), Register[uint]("idle", registry))
+
+		done := make(chan error)
+		go obs.SubscribeSync(nil, func(err error) {
+			done <- err
+		}, nil)
+
+		require.Eventually(t, func() bool {
+			return len(registry.Subscriptions()) == 1
+		}, time.Second, time.Millisecond)
+
+		id := registry.Subscriptions()[0].ID
+		require.True(t, registry.Cancel(id))
+		require.Equal(t, ErrCancelled, <-done)
+		require.Eventually(t, func() bool {
+			return len(registry.Subscriptions()) == 0
+		}, time.Second, time.Millisecond)
+		require.False(t, registry.Cancel(id))
+	})
+
+	t.Run("Register with Cancel while blocked", func(t *testing.T) {
+		registry := NewRegistry()
+		sub := Pipe1(Interval(time.Millisecond), Register[uint]("stuck", registry)).SubscribeOn()
+		defer sub.Stop()
+
+		// nobody reads the values yet
+		require.Eventually(t, func() bool {
+			subs := registry.Subscriptions()
+			return len(subs) == 1 && subs[0].State == SubscriptionBlocked
+		}, time.Second, time.Millisecond)
+
+		require.True(t, registry.Cancel(registry.Subscriptions()[0].ID))
+		require.Eventually(t, func() bool {
+			return registry.Subscriptions()[0].State == SubscriptionActive
+		}, time.Second, time.Millisecond)
+
+		// the pending value has been dropped
+		item := <-sub.ForEach()
+		require.Equal(t, ErrCancelled, item.Err())
+		require.Eventually(t, func() bool {
+			return len(registry.Subscriptions()) == 0
+		}, time.Second, time.Millisecond)
+	})
+
+	t.Run("Register with Cancel and a slow consumer", func(t *testing.T) {
+		var (
+			ignore   = goleak.IgnoreCurrent()
+			registry = NewRegistry()
+			done     = make(chan error, 1)
+		)
+
+		go Pipe1(Interval(time.Millisecond), Register[uint]("slow", registry)).SubscribeSync(func(uint) {
+			time.Sleep(time.Millisecond * 50)
+		}, func(err error) {
+			done <- err
+		}, nil)
+
+		require.Eventually(t, func() bool {
+			subs := registry.Subscriptions()
+			return len(subs) == 1 && subs[0].Items > 0
+		}, time.Second, time.Millisecond)
+
+		require.True(t, registry.Cancel(registry.Subscriptions()[0].ID))
+		select {
+		case err := <-done:
+			require.Equal(t, ErrCancelled, err)
+		case <-time.After(time.Second):
+			require.FailNow(t, "the subscription should end")
+		}
+		require.Eventually(t, func() bool {
+			return goleak.Find(ignore) == nil
+		}, time.Second, time.Millisecond*10)
+	})
+
+	t.Run("Register with the default registry", func(t *testing.T) {
+		checkObservableResults(t, Pipe1(Of2("a"), Register[string]("default")), []string{"a"}, nil, true)
+		require.Empty(t, DefaultRegistry().Subscriptions())
+	})
+}
+
+func TestRegistryHandler(t *testing.T) {
+	registry := NewRegistry()
+	obs := Pipe1(Interval(time.Hour), Register[uint]("idle", registry))
+
+	done := make(chan error)
+	go obs.SubscribeSync(nil, func(err error) {
+		done <- err
+	}, nil)
+
+	require.Eventually(t, func() bool {
+		return len(registry.Subscriptions()) == 1
+	}, time.Second, time.Millisecond)
+	id := strconv.FormatUint(registry.Subscriptions()[0].ID, 10)
+
+	t.Run("GET as text", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		registry.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Contains(t, rec.Body.String(), "process goroutines: ")
+		require.Regexp(t, `(?m)^ID\s+PIPELINE\s+STATE\s+ITEMS\s+UPTIME$`, rec.Body.String())
+		require.Regexp(t, `(?m)^`+id+`\s+idle\s+active\s+0\s+`, rec.Body.String())
+	})
+
+	t.Run("GET as JSON", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		registry.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/?format=json", nil))
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+		var dump struct {
+			ProcessGoroutines int
+			Subscriptions     []SubscriptionInfo
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &dump))
+		require.Positive(t, dump.ProcessGoroutines)
+		require.Len(t, dump.Subscriptions, 1)
+		require.Equal(t, "idle", dump.Subscriptions[0].Pipeline)
+		require.Equal(t, SubscriptionActive, dump.Subscriptions[0].State)
+	})
+
+	t.Run("DELETE with invalid id", func(t *testing.T) {
+		for target, code := range map[string]int{
+			"/":           http.StatusBadRequest,
+			"/?id=abc":    http.StatusBadRequest,
+			"/?id=123456": http.StatusNotFound,
+		} {
+			rec := httptest.NewRecorder()
+			registry.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, target, nil))
+			require.Equal(t, code, rec.Code, target)
+		}
+	})
+
+	t.Run("POST is not allowed", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		registry.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader("")))
+		require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+		require.Equal(t, "GET, DELETE", rec.Header().Get("Allow"))
+	})
+
+	t.Run("DELETE cancels the subscription", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		registry.ServeHTTP(rec, httptest.NewRequest(http.MethodDelete, "/?id="+id, nil))
+		require.Equal(t, http.StatusNoContent, rec.Code)
+		require.Equal(t, ErrCancelled, <-done)
+	})
+}
@@ -0,0 +1,116 @@
+package rxgo
+
+// Result holds either a value or an error.
+type Result[T any] interface {
+	IsOk() bool
+	Value() T
+	Err() error
+	Get() (T, error)
+}
+
+type result[T any] struct {
+	v   T
+	err error
+}
+
+var _ Result[any] = (*result[any])(nil)
+
+func (r result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+func (r result[T]) Value() T {
+	return r.v
+}
+
+func (r result[T]) Err() error {
+	return r.err
+}
+
+func (r result[T]) Get() (T, error) {
+	return r.v, r.err
+}
+
+// Create a successful result.
+func Ok[T any](v T) Result[T] {
+	return result[T]{v: v}
+}
+
+// Create a failed result, the error must not be nil.
+func Fail[T any](err error) Result[T] {
+	if err == nil {
+		panic(`rxgo: "Fail" expected error`)
+	}
+	return result[T]{err: err}
+}
+
+// Turns every value into a successful Result, and an error into a failed Result followed by a complete notification, so the downstream never receives an error notification.
+func ToResults[T any]() OperatorFunc[T, Result[T]] {
+	return func(source Observable[T]) Observable[Result[T]] {
+		return createOperatorFunc(
+			source,
+			func(obs Observer[Result[T]], v T) {
+				obs.Next(Ok(v))
+			},
+			func(obs Observer[Result[T]], err error) {
+				obs.Next(Fail[T](err))
+				obs.Complete()
+			},
+			func(obs Observer[Result[T]]) {
+				obs.Complete()
+			},
+		)
+	}
+}
+
+// Emits the value of every successful Result, and errors on the first failed Result.
+func FromResults[T any]() OperatorFunc[Result[T], T] {
+	return func(source Observable[Result[T]]) Observable[T] {
+		return createOperatorFunc(
+			source,
+			func(obs Observer[T], v Result[T]) {
+				if err := v.Err(); err != nil {
+					obs.Error(err)
+					return
+				}
+				obs.Next(v.Value())
+			},
+			func(obs Observer[T], err error) {
+				obs.Error(err)
+			},
+			func(obs Observer[T]) {
+				obs.Complete()
+			},
+		)
+	}
+}
+
+// TryMap transforms every value with the mapper and emits the outcome as a Result. Unlike `Map`, an error returned by the mapper doesn't terminate the stream.
+func TryMap[T any, R any](mapper func(T, uint) (R, error)) OperatorFunc[T, Result[R]] {
+	if mapper == nil {
+		panic(`rxgo: "TryMap" expected mapper func`)
+	}
+	return func(source Observable[T]) Observable[Result[R]] {
+		var (
+			index uint
+		)
+		return createOperatorFunc(
+			source,
+			func(obs Observer[Result[R]], v T) {
+				output, err := mapper(v, index)
+				index++
+				if err != nil {
+					obs.Next(Fail[R](err))
+					return
+				}
+				obs.Next(Ok(output))
+			},
+			func(obs Observer[Result[R]], err error) {
+				obs.Error(err)
+			},
+			func(obs Observer[Result[R]]) {
+				obs.Complete()
+			},
+		)
+	}
+}
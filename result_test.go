@@ -0,0 +1,115 @@
+package rxgo
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResult(t *testing.T) {
+	t.Run("Ok", func(t *testing.T) {
+		r := Ok(10)
+		require.True(t, r.IsOk())
+		require.Equal(t, 10, r.Value())
+		require.Nil(t, r.Err())
+	})
+
+	t.Run("Fail", func(t *testing.T) {
+		var err = errors.New("failed")
+		r := Fail[int](err)
+		require.False(t, r.IsOk())
+		v, e := r.Get()
+		require.Equal(t, 0, v)
+		require.Equal(t, err, e)
+	})
+
+	t.Run("Fail with nil error", func(t *testing.T) {
+		require.Panics(t, func() {
+			Fail[int](nil)
+		})
+	})
+}
+
+func TestToResults(t *testing.T) {
+	t.Run("ToResults with Empty", func(t *testing.T) {
+		checkObservableResults(t, Pipe1(
+			Empty[uint](),
+			ToResults[uint](),
+		), []Result[uint]{}, nil, true)
+	})
+
+	t.Run("ToResults with values", func(t *testing.T) {
+		checkObservableResults(t, Pipe1(
+			Range[uint](1, 3),
+			ToResults[uint](),
+		), []Result[uint]{Ok[uint](1), Ok[uint](2), Ok[uint](3)}, nil, true)
+	})
+
+	t.Run("ToResults with error", func(t *testing.T) {
+		var err = errors.New("failed")
+		checkObservableResults(t, Pipe1(
+			Scheduled[any]("a", err, "b"),
+			ToResults[any](),
+		), []Result[any]{Ok[any]("a"), Fail[any](err)}, nil, true)
+	})
+}
+
+func TestFromResults(t *testing.T) {
+	t.Run("FromResults with values", func(t *testing.T) {
+		checkObservableResults(t, Pipe1(
+			Of2(Ok("a"), Ok("b")),
+			FromResults[string](),
+		), []string{"a", "b"}, nil, true)
+	})
+
+	t.Run("FromResults with failed result", func(t *testing.T) {
+		var err = errors.New("failed")
+		checkObservableResults(t, Pipe1(
+			Of2(Ok("a"), Fail[string](err), Ok("b")),
+			FromResults[string](),
+		), []string{"a"}, err, false)
+	})
+
+	t.Run("FromResults with error", func(t *testing.T) {
+		var err = errors.New("failed")
+		checkObservableResults(t, Pipe1(
+			Throw[Result[string]](func() error {
+				return err
+			}),
+			FromResults[string](),
+		), []string{}, err, false)
+	})
+}
+
+func TestTryMap(t *testing.T) {
+	var errOdd = errors.New("odd number")
+
+	t.Run("TryMap should continue after error", func(t *testing.T) {
+		checkObservableResults(t, Pipe1(
+			Range[uint](1, 4),
+			TryMap(func(v uint, _ uint) (uint, error) {
+				if v%2 == 1 {
+					return 0, errOdd
+				}
+				return v * 10, nil
+			}),
+		), []Result[uint]{Fail[uint](errOdd), Ok[uint](20), Fail[uint](errOdd), Ok[uint](40)}, nil, true)
+	})
+
+	t.Run("TryMap with FromResults", func(t *testing.T) {
+		checkObservableResults(t, Pipe3(
+			Range[uint](1, 4),
+			TryMap(func(v uint, _ uint) (uint, error) {
+				if v%2 == 1 {
+					return 0, errOdd
+				}
+				return v, nil
+			}),
+			Filter(func(v Result[uint], _ uint) bool {
+				return v.IsOk()
+			}),
+			FromResults[uint](),
+		), []uint{2, 4}, nil, true)
+	})
+}
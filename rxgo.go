@@ -9,18 +9,20 @@ type (
 	OnNextFunc[T any] func(T)
 
 	// OnErrorFunc defines a function that computes a value from an error.
-	OnErrorFunc                   func(error)
-	OnCompleteFunc                func()
-	FinalizerFunc                 func()
-	ErrorFunc                     func() error
-	OperatorFunc[I any, O any]    func(source Observable[I]) Observable[O]
-	DurationFunc[T any, R any]    func(value T) Observable[R]
-	PredicateFunc[T any]          func(value T, index uint) bool
-	ProjectionFunc[T any, R any]  func(value T, index uint) Observable[R]
-	ComparerFunc[A any, B any]    func(prev A, curr B) int8
-	ComparatorFunc[A any, B any]  func(prev A, curr B) bool
-	AccumulatorFunc[A any, V any] func(acc A, value V, index uint) (A, error)
-	ObservableFunc[T any]         func(subscriber Subscriber[T])
+	OnErrorFunc                     func(error)
+	OnCompleteFunc                  func()
+	FinalizerFunc                   func()
+	ErrorFunc                       func() error
+	OperatorFunc[I any, O any]      func(source Observable[I]) Observable[O]
+	DurationFunc[T any, R any]      func(value T) Observable[R]
+	PredicateFunc[T any]            func(value T, index uint) bool
+	PredicateCtxFunc[T any]         func(ctx context.Context, value T, index uint) bool
+	ProjectionFunc[T any, R any]    func(value T, index uint) Observable[R]
+	ProjectionCtxFunc[T any, R any] func(ctx context.Context, value T, index uint) Observable[R]
+	ComparerFunc[A any, B any]      func(prev A, curr B) int8
+	ComparatorFunc[A any, B any]    func(prev A, curr B) bool
+	AccumulatorFunc[A any, V any]   func(acc A, value V, index uint) (A, error)
+	ObservableFunc[T any]           func(subscriber Subscriber[T])
 )
 
 type Observable[T any] interface {
@@ -48,6 +50,7 @@ type Observer[T any] interface {
 
 type Subscriber[T any] interface {
 	Stop()
+	// Send returns the channel of the subscriber. The notifications written to it directly bypass the `Next* (Error|Complete)?` grammar enforced by `Notification.Send`, which should be preferred.
 	Send() chan<- Notification[T]
 	ForEach() <-chan Notification[T]
 	Closed() <-chan struct{}
@@ -61,12 +64,25 @@ type Subject[T any] interface {
 }
 
 func newObservable[T any](obs ObservableFunc[T]) Observable[T] {
-	return &observableWrapper[T]{source: obs}
+	o := &observableWrapper[T]{
+		source:   obs,
+		assembly: newAssembly(0),
+		stage:    newStageName(0),
+		node:     newGraphNode(0),
+	}
+	onAssembly(o)
+	return o
 }
 
 type observableWrapper[T any] struct {
 	source    ObservableFunc[T]
 	connector func() Subject[T]
+	// only captured in debug mode
+	assembly *assembly
+	// only named with the global instrumentation
+	stage string
+	// only recorded while the graph is enabled
+	node *graphNode
 }
 
 var _ Observable[any] = (*observableWrapper[any])(nil)
@@ -76,11 +92,15 @@ func (o *observableWrapper[T]) SubscribeWith(subscriber Subscriber[T]) {
 }
 
 func (o *observableWrapper[T]) SubscribeOn(cb ...func()) Subscriber[T] {
+	onSubscribe(o)
 	var subscriber Subject[T]
 	if o.connector != nil {
 		subscriber = o.connector()
 	} else {
-		subscriber = NewSubscriber[T]()
+		sub := NewSubscriber[T]()
+		sub.debug = o.operatorState()
+		sub.stage = o.stageState()
+		subscriber = sub
 	}
 	finalizer := func() {}
 	if len(cb) > 0 {
@@ -89,18 +109,23 @@ func (o *observableWrapper[T]) SubscribeOn(cb ...func()) Subscriber[T] {
 	go func() {
 		defer subscriber.Unsubscribe()
 		defer finalizer()
+		defer recoverAsError[T](subscriber)
 		o.source(subscriber)
 	}()
 	return subscriber
 }
 
 func (o *observableWrapper[T]) SubscribeSync(onNext func(T), onError func(error), onComplete func()) {
+	onSubscribe(o)
 	ctx := context.Background()
 	subscriber := NewSafeSubscriber(onNext, onError, onComplete)
+	subscriber.debug = o.operatorState()
+	subscriber.stage = o.stageState()
 	wg := new(sync.WaitGroup)
 	wg.Add(2)
 	go func() {
 		defer wg.Done()
+		defer recoverAsError[T](subscriber)
 		o.source(subscriber)
 	}()
 	go func() {
@@ -110,9 +135,42 @@ func (o *observableWrapper[T]) SubscribeSync(onNext func(T), onError func(error)
 	wg.Wait()
 }
 
+func (o *observableWrapper[T]) operatorState() *operatorState {
+	if o.assembly == nil {
+		return nil
+	}
+	return &operatorState{assembly: o.assembly}
+}
+
+func (o *observableWrapper[T]) stageState() *stageState {
+	if o.stage == "" {
+		return nil
+	}
+	return &stageState{name: o.stage, metrics: GetMetrics()}
+}
+
+func (o *observableWrapper[T]) graphNode() *graphNode {
+	return o.node
+}
+
 func consumeStreamUntil[T any](ctx context.Context, sub *safeSubscriber[T], finalizer FinalizerFunc) {
+	var (
+		terminated bool
+	)
+
 	defer sub.Unsubscribe()
 	defer finalizer()
+	defer func() {
+		// a panic in `onNext` is reported to `onError`, but we can't do much
+		// if `onError` or `onComplete` panics
+		if r := recover(); r != nil {
+			if terminated {
+				panic(r)
+			}
+			sub.Stop()
+			sub.dst.Error(newPanicError(r))
+		}
+	}()
 
 observe:
 	for {
@@ -131,12 +189,14 @@ observe:
 
 			// handle `Error` notification
 			if err := item.Err(); err != nil {
+				terminated = true
 				sub.dst.Error(err)
 				break observe
 			}
 
 			// handle `Complete` notification
 			if item.Done() {
+				terminated = true
 				sub.dst.Complete()
 				break observe
 			}
@@ -0,0 +1,124 @@
+package rxtest
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/reactivex/rxgo/v3"
+	"go.uber.org/goleak"
+)
+
+// ErrConformance is the error emitted by the failing sources of `VerifyOperator`.
+var ErrConformance = errors.New("rxtest: conformance error")
+
+// ConformanceConfig configures the scenarios run by `VerifyOperator`.
+type ConformanceConfig[T any] struct {
+	// Values are emitted by the sources of the scenarios, three zero values are used by default.
+	Values []T
+	// Timeout is the maximum time to wait for the operator to terminate, one second by default.
+	Timeout time.Duration
+}
+
+// VerifyOperator runs the operator against a set of scenarios (empty source, source with values, upstream error, slow consumer, early unsubscribe and never-completing source), and asserts that it doesn't leak any goroutine and that its notifications follow the Observable contract. The operator is expected to terminate exactly once whenever its source terminates.
+//
+// The scenarios run as subtests if tb is a `*testing.T`, otherwise their failures are prefixed by their name. The leak detection inspects every goroutine of the process, the test must not run in parallel with other tests.
+func VerifyOperator[T any, R any](tb testing.TB, operator rxgo.OperatorFunc[T, R], config ...ConformanceConfig[T]) {
+	tb.Helper()
+
+	var cfg ConformanceConfig[T]
+	if len(config) > 0 {
+		cfg = config[0]
+	}
+	if len(cfg.Values) == 0 {
+		cfg.Values = make([]T, 3)
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = time.Second
+	}
+
+	// sources are built lazily, so their goroutines are spawned within the scenario
+	var (
+		values = func() rxgo.Observable[T] {
+			return rxgo.Of2(cfg.Values[0], cfg.Values[1:]...)
+		}
+		infinite = func() rxgo.Observable[T] {
+			return rxgo.Pipe1(rxgo.Interval(time.Millisecond), rxgo.Map(func(_ uint, i uint) (T, error) {
+				return cfg.Values[int(i)%len(cfg.Values)], nil
+			}))
+		}
+	)
+
+	scenarios := []struct {
+		name   string
+		source func() rxgo.Observable[T]
+		delay  time.Duration
+		// wait before unsubscribing, a negative duration waits for the termination
+		unsubscribeAfter time.Duration
+	}{
+		{name: "empty source", source: rxgo.Empty[T], unsubscribeAfter: -1},
+		{name: "source with values", source: values, unsubscribeAfter: -1},
+		{name: "upstream error", source: func() rxgo.Observable[T] {
+			return rxgo.Concat(values(), rxgo.Throw[T](func() error {
+				return ErrConformance
+			}))
+		}, unsubscribeAfter: -1},
+		{name: "slow consumer", source: values, delay: time.Millisecond * 5, unsubscribeAfter: -1},
+		{name: "early unsubscribe", source: infinite},
+		{name: "never-completing source", source: infinite, unsubscribeAfter: time.Millisecond * 20},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		run := func(t testing.TB) {
+			ignore := goleak.IgnoreCurrent()
+
+			o := subscribe(t, operator(s.source()), s.delay)
+			if s.unsubscribeAfter < 0 {
+				o.AwaitTerminal(cfg.Timeout)
+				// give the operator a chance to misbehave after the terminal notification
+				select {
+				case <-o.done:
+				case <-time.After(time.Millisecond * 20):
+				}
+			} else {
+				time.Sleep(s.unsubscribeAfter)
+			}
+			o.Dispose()
+
+			select {
+			case <-o.done:
+			case <-time.After(cfg.Timeout):
+				t.Errorf("rxtest: the operator is still emitting %v after unsubscribing", cfg.Timeout)
+			}
+
+			o.AssertGrammar()
+			if s.unsubscribeAfter < 0 {
+				o.AssertTerminated()
+			}
+
+			if err := goleak.Find(ignore); err != nil {
+				t.Errorf("rxtest: leaked goroutines: %v", err)
+			}
+		}
+
+		if t, ok := tb.(*testing.T); ok {
+			t.Run(s.name, func(t *testing.T) {
+				run(t)
+			})
+		} else {
+			run(scenarioTB{TB: tb, name: s.name})
+		}
+	}
+}
+
+// scenarioTB prefixes the failures by the name of the scenario.
+type scenarioTB struct {
+	testing.TB
+	name string
+}
+
+func (s scenarioTB) Errorf(format string, args ...any) {
+	s.TB.Helper()
+	s.TB.Errorf(s.name+": "+format, args...)
+}
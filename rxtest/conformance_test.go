@@ -0,0 +1,113 @@
+package rxtest
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/reactivex/rxgo/v3"
+	"github.com/stretchr/testify/require"
+)
+
+// chattyObservable completes and then keeps emitting, violating the contract. It
+// writes to the channel directly, as `Notification.Send` would drop the value.
+type chattyObservable[T any] struct {
+	rxgo.Observable[T]
+	value T
+}
+
+func (o chattyObservable[T]) SubscribeOn(finalizer ...func()) rxgo.Subscriber[T] {
+	subscriber := rxgo.NewSubscriber[T]()
+	go func() {
+		defer subscriber.Unsubscribe()
+		for _, f := range finalizer {
+			defer f()
+		}
+		for _, item := range []rxgo.Notification[T]{rxgo.Complete[T](), rxgo.Next(o.value)} {
+			select {
+			case <-subscriber.Closed():
+				return
+			case subscriber.Send() <- item:
+			}
+		}
+	}()
+	return subscriber
+}
+
+func TestVerifyOperator(t *testing.T) {
+	t.Run("Map", func(t *testing.T) {
+		VerifyOperator(t, rxgo.Map(func(v string, _ uint) (int, error) {
+			return len(v), nil
+		}), ConformanceConfig[string]{Values: []string{"a", "bb", "ccc"}})
+	})
+
+	t.Run("Filter", func(t *testing.T) {
+		VerifyOperator(t, rxgo.Filter(func(v uint, _ uint) bool {
+			return v%2 == 0
+		}), ConformanceConfig[uint]{Values: []uint{1, 2, 3}})
+	})
+
+	t.Run("MapCtx", func(t *testing.T) {
+		VerifyOperator(t, rxgo.MapCtx(func(_ context.Context, v int, _ uint) (int, error) {
+			return v * 2, nil
+		}))
+	})
+
+	t.Run("ConcatMap", func(t *testing.T) {
+		VerifyOperator(t, rxgo.ConcatMap(func(v int, _ uint) rxgo.Observable[int] {
+			return rxgo.Of2(v, v)
+		}))
+	})
+
+	t.Run("Finalize", func(t *testing.T) {
+		VerifyOperator(t, rxgo.Finalize[int](func() {}))
+	})
+
+	t.Run("VerifyOperator with an operator leaking goroutines", func(t *testing.T) {
+		var (
+			tb      = &fakeTB{TB: t}
+			release = make(chan struct{})
+		)
+		defer close(release)
+
+		VerifyOperator(tb, func(source rxgo.Observable[int]) rxgo.Observable[int] {
+			go func() {
+				<-release
+			}()
+			return source
+		})
+
+		require.Len(t, tb.failures, 6)
+		for _, failure := range tb.failures {
+			require.Contains(t, failure, "rxtest: leaked goroutines")
+		}
+		require.True(t, strings.HasPrefix(tb.failures[0], "empty source: "))
+	})
+
+	t.Run("VerifyOperator with an operator emitting after completion", func(t *testing.T) {
+		tb := &fakeTB{TB: t}
+		VerifyOperator(tb, func(rxgo.Observable[int]) rxgo.Observable[int] {
+			return chattyObservable[int]{value: 1}
+		})
+
+		// the scenarios unsubscribing early may stop it before it misbehaves
+		for _, scenario := range []string{"empty source", "source with values", "upstream error", "slow consumer"} {
+			require.Contains(t, tb.failures, scenario+": rxtest: received notification #1 of kind 0 after the terminal notification #0")
+		}
+		for _, failure := range tb.failures {
+			require.Contains(t, failure, "after the terminal notification")
+		}
+	})
+
+	t.Run("operator emitting after completion", func(t *testing.T) {
+		tb := &fakeTB{TB: t}
+		o := Subscribe[int](tb, chattyObservable[int]{value: 1})
+		select {
+		case <-o.done:
+		case <-time.After(time.Second):
+		}
+		o.AssertGrammar().AssertTerminated()
+		require.Len(t, tb.failures, 1)
+	})
+}
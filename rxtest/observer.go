@@ -0,0 +1,297 @@
+// Package rxtest provides the tooling to test the Observables and operators built with rxgo.
+package rxtest
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/reactivex/rxgo/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+// Recorded is a notification received by a TestObserver, along with the time it was received.
+type Recorded[T any] struct {
+	Kind  rxgo.NotificationKind
+	Value T
+	Err   error
+	Time  time.Time
+}
+
+// TestObserver subscribes to an Observable and records every notification, so it can be asserted. All the methods are safe for concurrent use.
+type TestObserver[T any] struct {
+	tb         testing.TB
+	mu         sync.Mutex
+	records    []Recorded[T]
+	terminated bool
+	updated    chan struct{}
+	done       chan struct{}
+	stop       func()
+}
+
+// Subscribe subscribes a new TestObserver to the source, the notifications are recorded asynchronously. The notifications sent after the terminal one are recorded as well, see `AssertGrammar`.
+func Subscribe[T any](tb testing.TB, source rxgo.Observable[T]) *TestObserver[T] {
+	return subscribe(tb, source, 0)
+}
+
+// subscribe records the notifications, waiting for the delay after each of them
+// to simulate a slow consumer.
+func subscribe[T any](tb testing.TB, source rxgo.Observable[T], delay time.Duration) *TestObserver[T] {
+	var (
+		o = &TestObserver[T]{
+			tb:      tb,
+			updated: make(chan struct{}),
+			done:    make(chan struct{}),
+		}
+		upStream = source.SubscribeOn()
+	)
+
+	o.stop = upStream.Stop
+
+	go func() {
+		defer close(o.done)
+
+		for {
+			select {
+			case <-upStream.Closed():
+				return
+
+			case item, ok := <-upStream.ForEach():
+				if !ok {
+					return
+				}
+
+				o.record(item)
+				if delay > 0 {
+					time.Sleep(delay)
+				}
+			}
+		}
+	}()
+
+	return o
+}
+
+func (o *TestObserver[T]) record(item rxgo.Notification[T]) {
+	r := Recorded[T]{Kind: item.Kind(), Err: item.Err(), Time: time.Now()}
+	if item.Kind() == rxgo.NextKind {
+		r.Value = item.Value()
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.records = append(o.records, r)
+	if item.IsEnd() {
+		o.terminated = true
+	}
+	// wake up the goroutines awaiting
+	close(o.updated)
+	o.updated = make(chan struct{})
+}
+
+// Dispose unsubscribes from the source.
+func (o *TestObserver[T]) Dispose() {
+	o.stop()
+}
+
+// Records returns every notification received so far.
+func (o *TestObserver[T]) Records() []Recorded[T] {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return append([]Recorded[T](nil), o.records...)
+}
+
+// Values returns the values received so far.
+func (o *TestObserver[T]) Values() []T {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	values := make([]T, 0, len(o.records))
+	for _, r := range o.records {
+		if r.Kind == rxgo.NextKind {
+			values = append(values, r.Value)
+		}
+	}
+	return values
+}
+
+// Err returns the error received, if any.
+func (o *TestObserver[T]) Err() error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for _, r := range o.records {
+		if r.Kind == rxgo.ErrorKind {
+			return r.Err
+		}
+	}
+	return nil
+}
+
+// Completed reports whether the source has completed.
+func (o *TestObserver[T]) Completed() bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for _, r := range o.records {
+		if r.Kind == rxgo.CompleteKind {
+			return true
+		}
+	}
+	return false
+}
+
+// Terminated reports whether the source has completed or errored.
+func (o *TestObserver[T]) Terminated() bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.terminated
+}
+
+// AwaitCount blocks until at least `n` values are received or the source terminates. The test fails if it takes longer than the timeout.
+func (o *TestObserver[T]) AwaitCount(n int, timeout time.Duration) *TestObserver[T] {
+	o.tb.Helper()
+	if !o.await(func() bool {
+		return o.terminated || o.countValues() >= n
+	}, timeout) {
+		o.tb.Errorf("rxtest: expected %d values within %v, got %d", n, timeout, len(o.Values()))
+	}
+	return o
+}
+
+// AwaitTerminal blocks until the source completes or errors. The test fails if it takes longer than the timeout.
+func (o *TestObserver[T]) AwaitTerminal(timeout time.Duration) *TestObserver[T] {
+	o.tb.Helper()
+	if !o.await(func() bool {
+		return o.terminated
+	}, timeout) {
+		o.tb.Errorf("rxtest: expected the source to terminate within %v", timeout)
+	}
+	return o
+}
+
+// AssertValues asserts the values received so far, in order.
+func (o *TestObserver[T]) AssertValues(expected ...T) *TestObserver[T] {
+	o.tb.Helper()
+	if expected == nil {
+		expected = []T{}
+	}
+	assert.Equal(o.tb, expected, o.Values())
+	return o
+}
+
+// AssertValuesNoOrder asserts the values received so far, regardless of their order.
+func (o *TestObserver[T]) AssertValuesNoOrder(expected ...T) *TestObserver[T] {
+	o.tb.Helper()
+	assert.ElementsMatch(o.tb, expected, o.Values())
+	return o
+}
+
+// AssertNoValues asserts that no value has been received.
+func (o *TestObserver[T]) AssertNoValues() *TestObserver[T] {
+	o.tb.Helper()
+	assert.Empty(o.tb, o.Values())
+	return o
+}
+
+// AssertError asserts that the source errored with the given error.
+func (o *TestObserver[T]) AssertError(err error) *TestObserver[T] {
+	o.tb.Helper()
+	assert.Equal(o.tb, err, o.Err())
+	return o
+}
+
+// AssertErrorIs asserts that the source errored with an error matching the target, see `errors.Is`.
+func (o *TestObserver[T]) AssertErrorIs(target error) *TestObserver[T] {
+	o.tb.Helper()
+	if err := o.Err(); !errors.Is(err, target) {
+		o.tb.Errorf("rxtest: expected error matching %v, got %v", target, err)
+	}
+	return o
+}
+
+// AssertNoError asserts that the source hasn't errored.
+func (o *TestObserver[T]) AssertNoError() *TestObserver[T] {
+	o.tb.Helper()
+	assert.NoError(o.tb, o.Err())
+	return o
+}
+
+// AssertComplete asserts that the source has completed.
+func (o *TestObserver[T]) AssertComplete() *TestObserver[T] {
+	o.tb.Helper()
+	if !o.Completed() {
+		o.tb.Errorf("rxtest: expected the source to complete")
+	}
+	return o
+}
+
+// AssertNotComplete asserts that the source hasn't completed.
+func (o *TestObserver[T]) AssertNotComplete() *TestObserver[T] {
+	o.tb.Helper()
+	if o.Completed() {
+		o.tb.Errorf("rxtest: expected the source not to complete")
+	}
+	return o
+}
+
+// AssertGrammar asserts that the notifications follow the Observable contract: any number of values, followed by at most one error or completion.
+func (o *TestObserver[T]) AssertGrammar() *TestObserver[T] {
+	o.tb.Helper()
+	terminal := -1
+	for i, r := range o.Records() {
+		if terminal >= 0 {
+			o.tb.Errorf("rxtest: received notification #%d of kind %d after the terminal notification #%d", i, r.Kind, terminal)
+			break
+		}
+		if r.Kind != rxgo.NextKind {
+			terminal = i
+		}
+	}
+	return o
+}
+
+// AssertTerminated asserts that exactly one terminal notification has been received.
+func (o *TestObserver[T]) AssertTerminated() *TestObserver[T] {
+	o.tb.Helper()
+	count := 0
+	for _, r := range o.Records() {
+		if r.Kind != rxgo.NextKind {
+			count++
+		}
+	}
+	if count != 1 {
+		o.tb.Errorf("rxtest: expected exactly one terminal notification, got %d", count)
+	}
+	return o
+}
+
+// await blocks until the condition is met, it's evaluated with the lock held.
+func (o *TestObserver[T]) await(condition func() bool, timeout time.Duration) bool {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		o.mu.Lock()
+		ok, updated := condition(), o.updated
+		o.mu.Unlock()
+		if ok {
+			return true
+		}
+
+		select {
+		case <-updated:
+		case <-timer.C:
+			return false
+		}
+	}
+}
+
+// countValues must be called with the lock held.
+func (o *TestObserver[T]) countValues() int {
+	count := 0
+	for _, r := range o.records {
+		if r.Kind == rxgo.NextKind {
+			count++
+		}
+	}
+	return count
+}
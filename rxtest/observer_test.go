@@ -0,0 +1,96 @@
+package rxtest
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/reactivex/rxgo/v3"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+)
+
+func TestMain(m *testing.M) {
+	goleak.VerifyTestMain(m)
+}
+
+// fakeTB records the failures instead of failing the test.
+type fakeTB struct {
+	testing.TB
+	failures []string
+}
+
+func (f *fakeTB) Helper() {}
+
+func (f *fakeTB) Errorf(format string, args ...any) {
+	f.failures = append(f.failures, fmt.Sprintf(format, args...))
+}
+
+func TestTestObserver(t *testing.T) {
+	t.Run("TestObserver with Empty", func(t *testing.T) {
+		Subscribe(t, rxgo.Empty[uint]()).
+			AwaitTerminal(time.Second).
+			AssertNoValues().
+			AssertValues().
+			AssertNoError().
+			AssertComplete()
+	})
+
+	t.Run("TestObserver with values", func(t *testing.T) {
+		o := Subscribe(t, rxgo.Range[uint](1, 3)).
+			AwaitTerminal(time.Second).
+			AssertValues(1, 2, 3).
+			AssertValuesNoOrder(3, 1, 2).
+			AssertComplete()
+
+		records := o.Records()
+		require.Len(t, records, 4)
+		require.Equal(t, rxgo.CompleteKind, records[3].Kind)
+		for i := 1; i < len(records); i++ {
+			require.False(t, records[i].Time.Before(records[i-1].Time))
+		}
+	})
+
+	t.Run("TestObserver with error", func(t *testing.T) {
+		var err = errors.New("failed")
+		Subscribe(t, rxgo.Scheduled[any]("a", fmt.Errorf("wrapped: %w", err))).
+			AwaitTerminal(time.Second).
+			AssertValues("a").
+			AssertErrorIs(err).
+			AssertNotComplete()
+	})
+
+	t.Run("TestObserver with AwaitCount", func(t *testing.T) {
+		o := Subscribe(t, rxgo.Interval(time.Millisecond)).
+			AwaitCount(3, time.Second)
+		o.Dispose()
+		require.GreaterOrEqual(t, len(o.Values()), 3)
+		require.False(t, o.Terminated())
+	})
+
+	t.Run("TestObserver with failing assertions", func(t *testing.T) {
+		var (
+			tb  = &fakeTB{TB: t}
+			err = errors.New("failed")
+		)
+		o := Subscribe[uint](tb, rxgo.Never[uint]()).
+			AwaitCount(1, time.Millisecond*10)
+		o.Dispose()
+		require.Len(t, tb.failures, 1)
+
+		Subscribe(tb, rxgo.Of2[uint](1, 2)).
+			AwaitTerminal(time.Second).
+			AssertValues(2, 1).
+			AssertError(err).
+			AssertErrorIs(err).
+			AssertNotComplete()
+		require.Len(t, tb.failures, 5)
+
+		o = Subscribe(tb, rxgo.Interval(time.Hour)).
+			AwaitTerminal(time.Millisecond * 10).
+			AssertComplete()
+		o.Dispose()
+		require.Len(t, tb.failures, 7)
+	})
+}
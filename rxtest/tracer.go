@@ -0,0 +1,102 @@
+package rxtest
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/reactivex/rxgo/v3"
+)
+
+// RecordedSpan is a span started by a Tracer.
+type RecordedSpan struct {
+	ID uint64
+	// ParentID is the ID of the parent span, zero for a root span.
+	ParentID uint64
+	Name     string
+	Errors   []error
+	Start    time.Time
+	End      time.Time
+	Ended    bool
+}
+
+// Tracer is an in-memory `rxgo.Tracer` recording every span, so the correlation of the values with their originating request can be asserted. It's safe for concurrent use.
+type Tracer struct {
+	mu    sync.Mutex
+	spans []*RecordedSpan
+}
+
+var _ rxgo.Tracer = (*Tracer)(nil)
+
+type spanKey struct{}
+
+// NewTracer creates an empty Tracer.
+func NewTracer() *Tracer {
+	return &Tracer{}
+}
+
+// Start starts a span, as a child of the span of the context if any.
+func (t *Tracer) Start(ctx context.Context, stage string) (context.Context, rxgo.Span) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	s := &RecordedSpan{ID: uint64(len(t.spans) + 1), Name: stage, Start: time.Now()}
+	s.ParentID = SpanIDFromContext(ctx)
+	t.spans = append(t.spans, s)
+
+	span := &recordingSpan{tracer: t, id: s.ID}
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+// Spans returns every span started so far, in order.
+func (t *Tracer) Spans() []RecordedSpan {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	spans := make([]RecordedSpan, len(t.spans))
+	for i, s := range t.spans {
+		spans[i] = *s
+		spans[i].Errors = append([]error(nil), s.Errors...)
+	}
+	return spans
+}
+
+// SpansNamed returns the spans started for the stage.
+func (t *Tracer) SpansNamed(stage string) []RecordedSpan {
+	var spans []RecordedSpan
+	for _, s := range t.Spans() {
+		if s.Name == stage {
+			spans = append(spans, s)
+		}
+	}
+	return spans
+}
+
+// recordingSpan is the `rxgo.Span` handed out by a Tracer.
+type recordingSpan struct {
+	tracer *Tracer
+	id     uint64
+}
+
+// SpanIDFromContext returns the ID of the span of the context started by a Tracer, zero if none.
+func SpanIDFromContext(ctx context.Context) uint64 {
+	if span, ok := ctx.Value(spanKey{}).(*recordingSpan); ok {
+		return span.id
+	}
+	return 0
+}
+
+func (s *recordingSpan) RecordError(err error) {
+	s.tracer.mu.Lock()
+	defer s.tracer.mu.Unlock()
+	r := s.tracer.spans[s.id-1]
+	r.Errors = append(r.Errors, err)
+}
+
+func (s *recordingSpan) End() {
+	s.tracer.mu.Lock()
+	defer s.tracer.mu.Unlock()
+	r := s.tracer.spans[s.id-1]
+	if !r.Ended {
+		r.End, r.Ended = time.Now(), true
+	}
+}
@@ -0,0 +1,86 @@
+package rxtest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/reactivex/rxgo/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracer(t *testing.T) {
+	t.Run("Tracer correlates the stages with the request", func(t *testing.T) {
+		tracer := NewTracer()
+		rxgo.SetTracer(tracer)
+		t.Cleanup(rxgo.ResetTracer)
+
+		ctx, request := tracer.Start(context.Background(), "request")
+		Subscribe(t, rxgo.Pipe3(
+			rxgo.Of2(1, 2),
+			rxgo.WithItemContext[int](ctx),
+			rxgo.MergeMapItemCtx("fetch", func(ctx context.Context, v int, _ uint) rxgo.Observable[int] {
+				return rxgo.Of2(v, v*10)
+			}),
+			rxgo.MapItemCtx("span", func(ctx context.Context, v int, _ uint) (uint64, error) {
+				return SpanIDFromContext(ctx), nil
+			}),
+		)).AwaitTerminal(time.Second).AssertComplete()
+		request.End()
+
+		spans := tracer.Spans()
+		require.Len(t, spans, 7)
+		require.Equal(t, "request", spans[0].Name)
+		require.Equal(t, uint64(0), spans[0].ParentID)
+		require.True(t, spans[0].Ended)
+
+		require.Len(t, tracer.SpansNamed("fetch"), 2)
+		require.Len(t, tracer.SpansNamed("span"), 4)
+		for _, s := range spans[1:] {
+			require.Equal(t, spans[0].ID, s.ParentID)
+			require.True(t, s.Ended)
+		}
+	})
+
+	t.Run("Tracer names the spans after the stages", func(t *testing.T) {
+		tracer := NewTracer()
+		rxgo.SetTracer(tracer)
+		t.Cleanup(rxgo.ResetTracer)
+
+		Subscribe(t, rxgo.Pipe3(
+			rxgo.Of2(1, 2),
+			rxgo.WithItemContext[int](context.Background()),
+			rxgo.MapItemCtx("double", func(_ context.Context, v int, _ uint) (int, error) {
+				return v * 2, nil
+			}),
+			rxgo.MapItemCtx("square", func(_ context.Context, v int, _ uint) (int, error) {
+				return v * v, nil
+			}),
+		)).AwaitTerminal(time.Second).AssertComplete()
+
+		require.Len(t, tracer.SpansNamed("double"), 2)
+		require.Len(t, tracer.SpansNamed("square"), 2)
+		require.Empty(t, tracer.SpansNamed("Map"))
+	})
+
+	t.Run("Tracer records the errors", func(t *testing.T) {
+		tracer := NewTracer()
+		rxgo.SetTracer(tracer)
+		t.Cleanup(rxgo.ResetTracer)
+
+		err := errors.New("failed")
+		Subscribe(t, rxgo.Pipe2(
+			rxgo.Of2(1),
+			rxgo.WithItemContext[int](context.Background()),
+			rxgo.MapItemCtx("", func(context.Context, int, uint) (int, error) {
+				return 0, err
+			}),
+		)).AwaitTerminal(time.Second).AssertError(err)
+
+		spans := tracer.SpansNamed("Map")
+		require.Len(t, spans, 1)
+		require.Equal(t, []error{err}, spans[0].Errors)
+		require.Equal(t, uint64(0), spans[0].ParentID)
+	})
+}
@@ -0,0 +1,199 @@
+//go:build go1.21
+
+package rxgo
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"runtime"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
+)
+
+// SpyConfig configures `Spy`.
+type SpyConfig struct {
+	// Logger receives the events, `slog.Default()` by default.
+	Logger *slog.Logger
+	// Level of the events, `slog.LevelInfo` by default.
+	Level slog.Level
+	// Marbles receives a live marble line per subscription, such as "spy: --a---b--|", nothing is rendered if nil. The lines of concurrent subscriptions sharing the same writer are interleaved.
+	Marbles io.Writer
+	// Frame is the duration of a "-" of the marble lines, 10ms by default.
+	Frame time.Duration
+}
+
+var spySubscriptions atomic.Uint64
+
+// Mirrors the source Observable and logs its subscribe, next, error, complete and unsubscribe events through `log/slog`, along with the time elapsed since the subscription and the goroutine emitting them. Optionally, it renders a live marble line per subscription, where "-" is a frame, a value is printed as is (or within parentheses if it's longer than one character), "|" is the completion, "#" an error and "!" the unsubscription.
+func Spy[T any](name string, config ...SpyConfig) OperatorFunc[T, T] {
+	var cfg SpyConfig
+	if len(config) > 0 {
+		cfg = config[0]
+	}
+	if cfg.Frame <= 0 {
+		cfg.Frame = time.Millisecond * 10
+	}
+	return func(source Observable[T]) Observable[T] {
+		return newObservable(func(subscriber Subscriber[T]) {
+			var (
+				wg    = new(sync.WaitGroup)
+				s     = newSpyState(name, cfg)
+				index uint
+			)
+
+			s.log("subscribe")
+			s.marble(name + ": ")
+
+			wg.Add(1)
+
+			var (
+				upStream     = source.SubscribeOn(wg.Done)
+				ticks        <-chan time.Time
+				unsubscribed bool
+			)
+
+			if cfg.Marbles != nil {
+				ticker := time.NewTicker(cfg.Frame)
+				defer ticker.Stop()
+				ticks = ticker.C
+			}
+
+		loop:
+			for {
+				select {
+				case <-subscriber.Closed():
+					upStream.Stop()
+					unsubscribed = true
+					break loop
+
+				case <-ticks:
+					// a panic of the logger or the writer stops the upstream as any error would
+					if err := catchPanic(func() {
+						s.marble("-")
+					}); err != nil {
+						upStream.Stop()
+						Error[T](err).Send(subscriber)
+						break loop
+					}
+
+				case item, ok := <-upStream.ForEach():
+					if !ok {
+						break loop
+					}
+
+					if err := catchPanic(func() {
+						logNotification(s, item, index)
+					}); err != nil {
+						upStream.Stop()
+						Error[T](err).Send(subscriber)
+						break loop
+					}
+					if !item.IsEnd() {
+						index++
+					}
+
+					if !item.Send(subscriber) {
+						upStream.Stop()
+						unsubscribed = true
+						break loop
+					}
+
+					if item.IsEnd() {
+						break loop
+					}
+				}
+			}
+
+			wg.Wait()
+
+			if unsubscribed {
+				s.log("unsubscribe")
+				s.marble("!\n")
+			}
+		})
+	}
+}
+
+type spyState struct {
+	name         string
+	cfg          SpyConfig
+	logger       *slog.Logger
+	subscription uint64
+	start        time.Time
+}
+
+func newSpyState(name string, cfg SpyConfig) *spyState {
+	logger := cfg.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &spyState{
+		name:         name,
+		cfg:          cfg,
+		logger:       logger,
+		subscription: spySubscriptions.Add(1),
+		start:        time.Now(),
+	}
+}
+
+// logNotification records the notification of the source.
+func logNotification[T any](s *spyState, item Notification[T], index uint) {
+	if err := item.Err(); err != nil {
+		s.log("error", slog.Any("error", err))
+		s.marble("#\n")
+	} else if item.Done() {
+		s.log("complete")
+		s.marble("|\n")
+	} else {
+		s.log("next", slog.Any("value", item.Value()), slog.Uint64("index", uint64(index)))
+		s.marble(marbleValue(item.Value()))
+	}
+}
+
+func (s *spyState) log(event string, attrs ...slog.Attr) {
+	ctx := context.Background()
+	if !s.logger.Enabled(ctx, s.cfg.Level) {
+		return
+	}
+	attrs = append([]slog.Attr{
+		slog.String("stream", s.name),
+		slog.String("event", event),
+		slog.Uint64("subscription", s.subscription),
+		slog.Duration("elapsed", time.Since(s.start)),
+		slog.Uint64("goroutine", goroutineID()),
+	}, attrs...)
+	s.logger.LogAttrs(ctx, s.cfg.Level, "rxgo: spy "+event, attrs...)
+}
+
+func (s *spyState) marble(symbol string) {
+	if s.cfg.Marbles != nil {
+		io.WriteString(s.cfg.Marbles, symbol)
+	}
+}
+
+func marbleValue(v any) string {
+	str := fmt.Sprint(v)
+	if utf8.RuneCountInString(str) == 1 {
+		return str
+	}
+	return "(" + str + ")"
+}
+
+// goroutineID parses the ID of the current goroutine from its stack, such as
+// "goroutine 18 [running]:".
+func goroutineID() uint64 {
+	var buf [64]byte
+	b := buf[:runtime.Stack(buf[:], false)]
+	b = bytes.TrimPrefix(b, []byte("goroutine "))
+	if i := bytes.IndexByte(b, ' '); i >= 0 {
+		b = b[:i]
+	}
+	id, _ := strconv.ParseUint(string(b), 10, 64)
+	return id
+}
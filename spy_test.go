@@ -0,0 +1,197 @@
+//go:build go1.21
+
+package rxgo
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+)
+
+// syncBuffer is a bytes.Buffer safe for concurrent use.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+func spyEvents(t *testing.T, logs string) []map[string]any {
+	var events []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(logs), "\n") {
+		var event map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &event))
+		events = append(events, event)
+	}
+	return events
+}
+
+// panicWriter panics when the value 2 is rendered.
+type panicWriter struct{}
+
+func (panicWriter) Write(p []byte) (int, error) {
+	if string(p) == "2" {
+		panic("boom")
+	}
+	return len(p), nil
+}
+
+func TestSpy(t *testing.T) {
+	t.Run("Spy logs the events", func(t *testing.T) {
+		var (
+			logs   = new(syncBuffer)
+			logger = slog.New(slog.NewJSONHandler(logs, &slog.HandlerOptions{Level: slog.LevelDebug}))
+		)
+
+		checkObservableResults(t, Pipe1(Of2("a", "b"), Spy[string]("letters", SpyConfig{
+			Logger: logger,
+			Level:  slog.LevelDebug,
+		})), []string{"a", "b"}, nil, true)
+
+		events := spyEvents(t, logs.String())
+		require.Len(t, events, 4)
+		for i, kind := range []string{"subscribe", "next", "next", "complete"} {
+			require.Equal(t, kind, events[i]["event"])
+			require.Equal(t, "letters", events[i]["stream"])
+			require.Equal(t, "DEBUG", events[i]["level"])
+			require.Equal(t, "rxgo: spy "+kind, events[i]["msg"])
+			require.Contains(t, events[i], "elapsed")
+			require.NotZero(t, events[i]["goroutine"])
+			require.Equal(t, events[0]["subscription"], events[i]["subscription"])
+		}
+		require.Equal(t, "b", events[2]["value"])
+		require.Equal(t, float64(1), events[2]["index"])
+	})
+
+	t.Run("Spy logs the error and the unsubscription", func(t *testing.T) {
+		var (
+			logs   = new(syncBuffer)
+			logger = slog.New(slog.NewJSONHandler(logs, nil))
+			err    = errors.New("failed")
+		)
+
+		checkObservable(t, Pipe1(Throw[string](func() error {
+			return err
+		}), Spy[string]("throw", SpyConfig{Logger: logger})), err, false)
+
+		events := spyEvents(t, logs.String())
+		require.Len(t, events, 2)
+		require.Equal(t, "error", events[1]["event"])
+		require.Equal(t, "failed", events[1]["error"])
+
+		logs = new(syncBuffer)
+		logger = slog.New(slog.NewJSONHandler(logs, nil))
+		checkObservableResults(t, Pipe2(
+			Interval(time.Millisecond),
+			Spy[uint]("interval", SpyConfig{Logger: logger}),
+			Take[uint](2),
+		), []uint{0, 1}, nil, true)
+
+		require.Eventually(t, func() bool {
+			events := spyEvents(t, logs.String())
+			return events[len(events)-1]["event"] == "unsubscribe"
+		}, time.Second, time.Millisecond)
+	})
+
+	t.Run("Spy respects the level", func(t *testing.T) {
+		var (
+			logs   = new(syncBuffer)
+			logger = slog.New(slog.NewJSONHandler(logs, nil))
+		)
+
+		checkObservableResults(t, Pipe1(Of2(1, 2), Spy[int]("quiet", SpyConfig{
+			Logger: logger,
+			Level:  slog.LevelDebug,
+		})), []int{1, 2}, nil, true)
+		require.Empty(t, logs.String())
+	})
+
+	t.Run("Spy renders marbles", func(t *testing.T) {
+		var (
+			marbles = new(syncBuffer)
+			logger  = slog.New(slog.NewTextHandler(new(syncBuffer), nil))
+		)
+
+		checkObservableResults(t, Pipe1(Of2("a", "b", "cd"), Spy[string]("values", SpyConfig{
+			Logger:  logger,
+			Marbles: marbles,
+			Frame:   time.Hour,
+		})), []string{"a", "b", "cd"}, nil, true)
+		require.Equal(t, "values: ab(cd)|\n", marbles.String())
+
+		var err = errors.New("failed")
+		marbles = new(syncBuffer)
+		checkObservable(t, Pipe1(Throw[string](func() error {
+			return err
+		}), Spy[string]("throw", SpyConfig{
+			Logger:  logger,
+			Marbles: marbles,
+		})), err, false)
+		require.Equal(t, "throw: #\n", marbles.String())
+	})
+
+	t.Run("Spy renders live marbles", func(t *testing.T) {
+		var (
+			marbles = new(syncBuffer)
+			logger  = slog.New(slog.NewTextHandler(new(syncBuffer), nil))
+		)
+
+		checkObservableResults(t, Pipe2(
+			Interval(time.Millisecond*20),
+			Spy[uint]("interval", SpyConfig{
+				Logger:  logger,
+				Marbles: marbles,
+				Frame:   time.Millisecond * 5,
+			}),
+			Take[uint](2),
+		), []uint{0, 1}, nil, true)
+
+		require.Eventually(t, func() bool {
+			return strings.HasSuffix(marbles.String(), "!\n")
+		}, time.Second, time.Millisecond)
+
+		line := marbles.String()
+		require.True(t, strings.HasPrefix(line, "interval: -"))
+		require.Regexp(t, `^interval: -+0-+1!\n$`, line)
+	})
+
+	t.Run("Spy stops the upstream on panic", func(t *testing.T) {
+		var (
+			ignore = goleak.IgnoreCurrent()
+			logger = slog.New(slog.NewTextHandler(new(syncBuffer), nil))
+			result []uint
+			err    error
+		)
+
+		Pipe1(Interval(time.Millisecond), Spy[uint]("interval", SpyConfig{
+			Logger:  logger,
+			Marbles: panicWriter{},
+		})).SubscribeSync(func(v uint) {
+			result = append(result, v)
+		}, func(e error) {
+			err = e
+		}, nil)
+
+		require.Equal(t, []uint{0, 1}, result)
+		require.ErrorAs(t, err, &PanicError{})
+		require.NoError(t, goleak.Find(ignore))
+	})
+}
@@ -2,6 +2,7 @@ package rxgo
 
 import (
 	"sync"
+	"sync/atomic"
 )
 
 type subscriber[T any] struct {
@@ -18,6 +19,15 @@ type subscriber[T any] struct {
 
 	// determine the channel was closed
 	closed bool
+
+	// determine a terminal notification was sent
+	terminated atomic.Bool
+
+	// only set in debug mode
+	debug *operatorState
+
+	// only set with the global instrumentation
+	stage *stageState
 }
 
 func NewSubscriber[T any](bufferCount ...uint) *subscriber[T] {
@@ -60,6 +70,23 @@ func (s *subscriber[T]) Send() chan<- Notification[T] {
 	return s.ch
 }
 
+// acceptNotification enforces the grammar, nothing is accepted once a terminal
+// notification has been accepted.
+func (s *subscriber[T]) acceptNotification(end bool) bool {
+	if end {
+		return s.terminated.CompareAndSwap(false, true)
+	}
+	return !s.terminated.Load()
+}
+
+func (s *subscriber[T]) operatorState() *operatorState {
+	return s.debug
+}
+
+func (s *subscriber[T]) stageState() *stageState {
+	return s.stage
+}
+
 // this will close the stream and stop the emission of the stream data
 func (s *subscriber[T]) Unsubscribe() {
 	s.mu.Lock()
@@ -0,0 +1,144 @@
+package rxgo
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+)
+
+func TestSubscriberGrammar(t *testing.T) {
+	t.Run("Next after Complete is dropped", func(t *testing.T) {
+		sub := NewSubscriber[int](10)
+		require.True(t, Next(1).Send(sub))
+		require.True(t, Complete[int]().Send(sub))
+		require.False(t, Next(2).Send(sub))
+		require.False(t, Error[int](errors.New("failed")).Send(sub))
+		require.False(t, Complete[int]().Send(sub))
+		require.Len(t, sub.ForEach(), 2)
+	})
+
+	t.Run("Next after Error is dropped", func(t *testing.T) {
+		sub := NewSubscriber[int](10)
+		require.True(t, Error[int](errors.New("failed")).Send(sub))
+		require.False(t, Next(1).Send(sub))
+		require.Len(t, sub.ForEach(), 1)
+	})
+}
+
+func TestPanicRecovery(t *testing.T) {
+	collect := func(obs Observable[int], onNext func(int)) (result []int, err error) {
+		obs.SubscribeSync(func(v int) {
+			onNext(v)
+			result = append(result, v)
+		}, func(e error) {
+			err = e
+		}, nil)
+		return
+	}
+
+	t.Run("Panic in operator callback", func(t *testing.T) {
+		result, err := collect(Pipe1(Of2(1, 2, 3), Map(func(v int, _ uint) (int, error) {
+			if v == 2 {
+				panic("boom")
+			}
+			return v, nil
+		})), func(int) {})
+		require.Equal(t, []int{1}, result)
+
+		var panicErr PanicError
+		require.ErrorAs(t, err, &panicErr)
+		require.Equal(t, "boom", panicErr.Value)
+		require.Contains(t, string(panicErr.Stack), "panic")
+		require.Equal(t, "panic: boom", err.Error())
+	})
+
+	t.Run("Panic with error value", func(t *testing.T) {
+		var sentinel = errors.New("sentinel")
+		_, err := collect(Pipe1(Of2(1), Map(func(v int, _ uint) (int, error) {
+			panic(sentinel)
+		})), func(int) {})
+		require.ErrorIs(t, err, sentinel)
+	})
+
+	t.Run("Panic in onNext", func(t *testing.T) {
+		result, err := collect(intInterval(), func(v int) {
+			if v == 2 {
+				panic("boom")
+			}
+		})
+		require.Equal(t, []int{0, 1}, result)
+		require.ErrorAs(t, err, &PanicError{})
+	})
+
+	t.Run("Panic in operator callback stops the upstream", func(t *testing.T) {
+		for name, operator := range map[string]OperatorFunc[int, int]{
+			"MapCtx": MapCtx(func(_ context.Context, v int, _ uint) (int, error) {
+				if v == 2 {
+					panic("boom")
+				}
+				return v, nil
+			}),
+			"FilterCtx": FilterCtx(func(_ context.Context, v int, _ uint) bool {
+				if v == 2 {
+					panic("boom")
+				}
+				return true
+			}),
+			"TapEach": TapEach(func(v int, _ uint) {
+				if v == 2 {
+					panic("boom")
+				}
+			}),
+		} {
+			ignore := goleak.IgnoreCurrent()
+			result, err := collect(Pipe1(intInterval(), operator), func(int) {})
+			require.Equal(t, []int{0, 1}, result, name)
+			require.ErrorAs(t, err, &PanicError{}, name)
+			require.NoError(t, goleak.Find(ignore), name)
+		}
+	})
+
+	t.Run("Panic in projection stops the upstream", func(t *testing.T) {
+		project := func(v int, _ uint) Observable[int] {
+			panic("boom")
+		}
+		for name, operator := range map[string]OperatorFunc[int, int]{
+			"ConcatMap": ConcatMap(project),
+			"SwitchMap": SwitchMap(project),
+		} {
+			ignore := goleak.IgnoreCurrent()
+			result, err := collect(Pipe1(Of2(1, 2), operator), func(int) {})
+			require.Empty(t, result, name)
+			require.ErrorAs(t, err, &PanicError{}, name)
+			require.NoError(t, goleak.Find(ignore), name)
+		}
+	})
+
+	t.Run("Panic in MergeMap projection", func(t *testing.T) {
+		_, err := collect(Pipe1(Of2(1, 2), MergeMap(func(v int, _ uint) Observable[int] {
+			if v == 2 {
+				panic("boom")
+			}
+			return Of2(v)
+		})), func(int) {})
+		require.ErrorAs(t, err, &PanicError{})
+	})
+
+	t.Run("Panic in ExhaustMap projection", func(t *testing.T) {
+		_, err := collect(Pipe1(Of2(1), ExhaustMap(func(v int, _ uint) Observable[int] {
+			panic("boom")
+		})), func(int) {})
+		require.ErrorAs(t, err, &PanicError{})
+	})
+}
+
+// intInterval emits an infinite sequence of int.
+func intInterval() Observable[int] {
+	return Pipe1(Interval(time.Millisecond), Map(func(v, _ uint) (int, error) {
+		return int(v), nil
+	}))
+}
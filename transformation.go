@@ -2,6 +2,7 @@ package rxgo
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"sync"
 	"sync/atomic"
@@ -25,7 +26,9 @@ func Buffer[T any, R any](closingNotifier Observable[R]) OperatorFunc[T, []T] {
 			wg.Add(2)
 
 			onError := func(err error) {
-				errOnce.CompareAndSwap(nil, &err)
+				if !errOnce.CompareAndSwap(nil, &err) {
+					onUndeliverableError(err)
+				}
 				cancel()
 			}
 
@@ -478,9 +481,18 @@ func ConcatMap[T any, R any](project ProjectionFunc[T, R]) OperatorFunc[T, R] {
 						break outerLoop
 					}
 
+					var inner Observable[R]
+					if err := catchPanic(func() {
+						inner = project(item.Value(), index)
+					}); err != nil {
+						upStream.Stop()
+						Error[R](err).Send(subscriber)
+						break outerLoop
+					}
+
 					wg.Add(1)
 					// we should wait the projection to complete
-					innerStream = project(item.Value(), index).SubscribeOn(wg.Done)
+					innerStream = inner.SubscribeOn(wg.Done)
 
 				innerLoop:
 					for {
@@ -522,6 +534,14 @@ func ConcatMap[T any, R any](project ProjectionFunc[T, R]) OperatorFunc[T, R] {
 	}
 }
 
+// Same as `ConcatMap`, but the projection receives a context which is cancelled when the inner Observable terminates, the subscriber stops or an error occurs.
+func ConcatMapCtx[T any, R any](project ProjectionCtxFunc[T, R]) OperatorFunc[T, R] {
+	if project == nil {
+		panic(`rxgo: "ConcatMapCtx" expected project func`)
+	}
+	return ConcatMap(withInnerContext(project))
+}
+
 // Projects each source value to an Observable which is merged in the output Observable only if the previous projected Observable has completed.
 func ExhaustMap[T any, R any](project ProjectionFunc[T, R]) OperatorFunc[T, R] {
 	if project == nil {
@@ -542,8 +562,16 @@ func ExhaustMap[T any, R any](project ProjectionFunc[T, R]) OperatorFunc[T, R] {
 
 			observeStream := func(ctx context.Context, index uint, value T) func() error {
 				return func() error {
+					var inner Observable[R]
+					// the projection runs on its own goroutine, a panic is reported as an error
+					if err := catchPanic(func() {
+						inner = project(value, index)
+					}); err != nil {
+						return err
+					}
+
 					var (
-						stream = project(value, index).SubscribeOn()
+						stream = inner.SubscribeOn()
 					)
 
 				innerLoop:
@@ -623,6 +651,14 @@ func ExhaustMap[T any, R any](project ProjectionFunc[T, R]) OperatorFunc[T, R] {
 	}
 }
 
+// Same as `ExhaustMap`, but the projection receives a context which is cancelled when the inner Observable terminates, the subscriber stops or an error occurs.
+func ExhaustMapCtx[T any, R any](project ProjectionCtxFunc[T, R]) OperatorFunc[T, R] {
+	if project == nil {
+		panic(`rxgo: "ExhaustMapCtx" expected project func`)
+	}
+	return ExhaustMap(withInnerContext(project))
+}
+
 // Recursively projects each source value to an Observable which is merged in the output Observable.
 func Expand[T any, R any](project ProjectionFunc[T, R]) OperatorFunc[T, Either[T, R]] {
 	return func(source Observable[T]) Observable[Either[T, R]] {
@@ -711,7 +747,8 @@ func GroupBy[T any, K comparable](keySelector func(value T) K) OperatorFunc[T, G
 		panic(`rxgo: "GroupBy" expected keySelector func`)
 	}
 	return func(source Observable[T]) Observable[GroupedObservable[K, T]] {
-		return newObservable(func(subscriber Subscriber[GroupedObservable[K, T]]) {
+		var obs Observable[GroupedObservable[K, T]]
+		obs = newObservable(func(subscriber Subscriber[GroupedObservable[K, T]]) {
 			var (
 				wg = new(sync.WaitGroup)
 			)
@@ -742,6 +779,7 @@ func GroupBy[T any, K comparable](keySelector func(value T) K) OperatorFunc[T, G
 
 					if item.Done() {
 						for k, kv := range keySet {
+							addGraphChild(obs, fmt.Sprintf("Group(%v)", k))
 							Next(NewGroupedObservable(k, func() Subject[T] {
 								return kv
 							})).Send(subscriber)
@@ -761,6 +799,7 @@ func GroupBy[T any, K comparable](keySelector func(value T) K) OperatorFunc[T, G
 
 			wg.Wait()
 		})
+		return obs
 	}
 }
 
@@ -794,26 +833,113 @@ func Map[T any, R any](mapper func(T, uint) (R, error)) OperatorFunc[T, R] {
 	}
 }
 
-// Projects each source value to an Observable which is merged in the output Observable.
+// Same as `Map`, but the mapper receives a context which is cancelled when the subscriber stops or the stream terminates.
+func MapCtx[T any, R any](mapper func(ctx context.Context, value T, index uint) (R, error)) OperatorFunc[T, R] {
+	if mapper == nil {
+		panic(`rxgo: "MapCtx" expected mapper func`)
+	}
+	return func(source Observable[T]) Observable[R] {
+		return createOperatorCtxFunc(
+			source,
+			func(ctx context.Context, obs Observer[R], v T, index uint) {
+				output, err := mapper(ctx, v, index)
+				if err != nil {
+					obs.Error(err)
+					return
+				}
+				obs.Next(output)
+			},
+			func(obs Observer[R], err error) {
+				obs.Error(err)
+			},
+			func(obs Observer[R]) {
+				obs.Complete()
+			},
+		)
+	}
+}
+
+// MergeConfig configures how `MergeMapWithConfig` subscribes to the inner Observables.
+type MergeConfig struct {
+	// Concurrent is the maximum number of inner Observables being subscribed to concurrently, zero means unlimited.
+	Concurrent uint
+	// MaxQueueSize is the maximum number of source values waiting for a free slot, zero means unbounded. It is only relevant when `Concurrent` is set.
+	MaxQueueSize uint
+	// Overflow defines what happens when the queue is full: `Block` stops consuming the source until a slot is freed, `Drop` discards the value.
+	Overflow BackpressureStrategy
+	// Stats, if provided, is updated with the number of active and queued inner Observables.
+	Stats *MergeStats
+}
+
+// MergeStats exposes the state of a merge for monitoring purposes. It is safe for concurrent use, and aggregates all the subscriptions sharing it.
+type MergeStats struct {
+	active  atomic.Int64
+	queued  atomic.Int64
+	dropped atomic.Int64
+}
+
+// Active returns the number of inner Observables currently subscribed to.
+func (s *MergeStats) Active() uint {
+	return uint(s.active.Load())
+}
+
+// Queued returns the number of source values waiting for a free slot.
+func (s *MergeStats) Queued() uint {
+	return uint(s.queued.Load())
+}
+
+// Dropped returns the number of source values discarded because the queue was full.
+func (s *MergeStats) Dropped() uint {
+	return uint(s.dropped.Load())
+}
+
+// Projects each source value to an Observable which is merged in the output Observable. At most `concurrent` inner Observables are subscribed to at the same time, the excess values are queued, see `MergeMapWithConfig`.
 func MergeMap[T any, R any](project ProjectionFunc[T, R], concurrent ...uint) OperatorFunc[T, R] {
-	// TODO: support concurrent
+	var config MergeConfig
+	if len(concurrent) > 0 {
+		config.Concurrent = concurrent[0]
+	}
+	return MergeMapWithConfig(project, config)
+}
+
+// Projects each source value to an Observable which is merged in the output Observable, limiting the number of concurrent inner subscriptions as per the config.
+func MergeMapWithConfig[T any, R any](project ProjectionFunc[T, R], config MergeConfig) OperatorFunc[T, R] {
+	if config.Stats == nil {
+		config.Stats = new(MergeStats)
+	}
 	return func(source Observable[T]) Observable[R] {
 		return newObservable(func(subscriber Subscriber[R]) {
 			var (
 				errOnce     = new(atomic.Pointer[error])
 				wg          = new(sync.WaitGroup)
+				mu          = new(sync.Mutex)
 				ctx, cancel = context.WithCancel(context.TODO())
+				stats       = config.Stats
+				stage       = stageOf(subscriber)
+				slotFreed   = make(chan struct{}, 1)
 			)
 
+			defer cancel()
+
 			wg.Add(1)
 
+			type pending struct {
+				value T
+				index uint
+				start time.Time
+			}
+
 			var (
 				index    uint
+				active   uint
+				queue    []pending
 				upStream = source.SubscribeOn(wg.Done)
 			)
 
 			onError := func(err error) {
-				errOnce.CompareAndSwap(nil, &err)
+				if !errOnce.CompareAndSwap(nil, &err) {
+					onUndeliverableError(err)
+				}
 				cancel()
 			}
 
@@ -848,15 +974,93 @@ func MergeMap[T any, R any](project ProjectionFunc[T, R], concurrent ...uint) Op
 				}
 			}
 
+			// must be called with the lock held
+			var subscribeInner func(p pending)
+			subscribeInner = func(p pending) {
+				active++
+				stats.active.Add(1)
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+
+					// the projection runs on its own goroutine, a panic is reported as an error
+					var inner Observable[R]
+					if err := catchPanic(func() {
+						inner = project(p.value, p.index)
+					}); err != nil {
+						onError(err)
+					} else {
+						innerWg := new(sync.WaitGroup)
+						innerWg.Add(1)
+						observeStream(inner.SubscribeOn(innerWg.Done))
+						innerWg.Wait()
+					}
+
+					// the value is processed once its inner Observable has terminated
+					if stage != nil {
+						stage.end(p.start)
+					}
+
+					mu.Lock()
+					defer mu.Unlock()
+					active--
+					stats.active.Add(-1)
+					// no need to subscribe to the queued values if we're stopping
+					if ctx.Err() != nil {
+						stats.queued.Add(-int64(len(queue)))
+						queue = nil
+						if stage != nil {
+							stage.queue(0)
+						}
+						return
+					}
+					if len(queue) > 0 {
+						next := queue[0]
+						queue = queue[1:]
+						stats.queued.Add(-1)
+						if stage != nil {
+							stage.queue(len(queue))
+						}
+						subscribeInner(next)
+					}
+					select {
+					case slotFreed <- struct{}{}:
+					default:
+					}
+				}()
+			}
+
+			isFull := func() bool {
+				mu.Lock()
+				defer mu.Unlock()
+				return config.Concurrent > 0 && active >= config.Concurrent &&
+					config.MaxQueueSize > 0 && uint(len(queue)) >= config.MaxQueueSize
+			}
+
 		outerLoop:
 			for {
+				var (
+					upStreamCh = upStream.ForEach()
+				)
+
+				// stop consuming the source until a slot is freed
+				if config.Overflow == Block && isFull() {
+					upStreamCh = nil
+				}
+
 				select {
 				case <-subscriber.Closed():
 					upStream.Stop()
 					cancel()
 					break outerLoop
 
-				case item, ok := <-upStream.ForEach():
+				case <-ctx.Done():
+					upStream.Stop()
+					break outerLoop
+
+				case <-slotFreed:
+
+				case item, ok := <-upStreamCh:
 					if !ok {
 						break outerLoop
 					}
@@ -871,10 +1075,27 @@ func MergeMap[T any, R any](project ProjectionFunc[T, R], concurrent ...uint) Op
 						break outerLoop
 					}
 
-					wg.Add(1)
-					subscription := project(item.Value(), index).SubscribeOn(wg.Done)
-					go observeStream(subscription)
+					p := pending{value: item.Value(), index: index}
 					index++
+
+					if stage != nil {
+						p.start = stage.begin()
+					}
+
+					mu.Lock()
+					switch {
+					case config.Concurrent == 0 || active < config.Concurrent:
+						subscribeInner(p)
+					case config.MaxQueueSize == 0 || uint(len(queue)) < config.MaxQueueSize:
+						queue = append(queue, p)
+						stats.queued.Add(1)
+						if stage != nil {
+							stage.queue(len(queue))
+						}
+					default:
+						stats.dropped.Add(1)
+					}
+					mu.Unlock()
 				}
 			}
 
@@ -890,6 +1111,14 @@ func MergeMap[T any, R any](project ProjectionFunc[T, R], concurrent ...uint) Op
 	}
 }
 
+// Same as `MergeMap`, but the projection receives a context which is cancelled when the inner Observable terminates, the subscriber stops or an error occurs.
+func MergeMapCtx[T any, R any](project ProjectionCtxFunc[T, R], concurrent ...uint) OperatorFunc[T, R] {
+	if project == nil {
+		panic(`rxgo: "MergeMapCtx" expected project func`)
+	}
+	return MergeMap(withInnerContext(project), concurrent...)
+}
+
 // Applies an accumulator function over the source Observable where the accumulator function itself returns an Observable, then each intermediate Observable returned is merged into the output Observable.
 func MergeScan[V any, A any](accumulator func(acc A, value V, index uint) Observable[A], seed A, concurrent ...uint) OperatorFunc[V, A] {
 	return func(source Observable[V]) Observable[A] {
@@ -910,10 +1139,15 @@ func MergeScan[V any, A any](accumulator func(acc A, value V, index uint) Observ
 			)
 
 			onError := func(err error) {
+				delivered := false
 				errOnce.Do(func() {
 					exception = err
+					delivered = true
 					cancel()
 				})
+				if !delivered {
+					onUndeliverableError(err)
+				}
 			}
 
 			finalValue.Store(&seed)
@@ -1089,7 +1323,9 @@ func SwitchMap[T any, R any](project func(value T, index uint) Observable[R]) Op
 			)
 
 			onError := func(err error) {
-				errOnce.CompareAndSwap(nil, &err)
+				if !errOnce.CompareAndSwap(nil, &err) {
+					onUndeliverableError(err)
+				}
 				cancel()
 			}
 
@@ -1170,8 +1406,17 @@ func SwitchMap[T any, R any](project func(value T, index uint) Observable[R]) Op
 						downStream.Stop()
 					}
 
+					var inner Observable[R]
+					if err := catchPanic(func() {
+						inner = project(item.Value(), index)
+					}); err != nil {
+						upStream.Stop()
+						onError(err)
+						break outerLoop
+					}
+
 					wg.Add(1)
-					downStream = project(item.Value(), index).SubscribeOn(wg.Done)
+					downStream = inner.SubscribeOn(wg.Done)
 					go observeStream(downStream)
 					index++
 				}
@@ -1188,3 +1433,67 @@ func SwitchMap[T any, R any](project func(value T, index uint) Observable[R]) Op
 		})
 	}
 }
+
+// Same as `SwitchMap`, but the projection receives a context which is cancelled when the inner Observable is superseded by a new one, terminates, the subscriber stops or an error occurs.
+func SwitchMapCtx[T any, R any](project ProjectionCtxFunc[T, R]) OperatorFunc[T, R] {
+	if project == nil {
+		panic(`rxgo: "SwitchMapCtx" expected project func`)
+	}
+	return SwitchMap(withInnerContext(project))
+}
+
+// subscriberContext returns a context which is cancelled once the subscriber is closed.
+func subscriberContext[T any](subscriber Subscriber[T]) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		select {
+		case <-subscriber.Closed():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, cancel
+}
+
+// withInnerContext converts a contextual projection, the context handed to the
+// projection lives as long as the subscription to the projected Observable.
+func withInnerContext[T any, R any](project ProjectionCtxFunc[T, R]) ProjectionFunc[T, R] {
+	return func(value T, index uint) Observable[R] {
+		return newObservable(func(subscriber Subscriber[R]) {
+			var (
+				wg          = new(sync.WaitGroup)
+				ctx, cancel = subscriberContext(subscriber)
+			)
+
+			defer cancel()
+
+			wg.Add(1)
+
+			var (
+				upStream = project(ctx, value, index).SubscribeOn(wg.Done)
+			)
+
+		loop:
+			for {
+				select {
+				case <-subscriber.Closed():
+					upStream.Stop()
+					break loop
+
+				case item, ok := <-upStream.ForEach():
+					if !ok {
+						break loop
+					}
+
+					item.Send(subscriber)
+					if item.IsEnd() {
+						break loop
+					}
+				}
+			}
+
+			cancel()
+			wg.Wait()
+		})
+	}
+}
@@ -1,11 +1,15 @@
 package rxgo
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
+
+	"github.com/stretchr/testify/require"
 )
 
 func TestBuffer(t *testing.T) {
@@ -529,6 +533,81 @@ func TestMergeMap(t *testing.T) {
 			}),
 		), true, err, false)
 	})
+
+	t.Run("MergeMap with concurrent 1", func(t *testing.T) {
+		checkObservableResults(t, Pipe1(
+			Of2("a", "b", "c"),
+			MergeMap(func(x string, _ uint) Observable[string] {
+				return Pipe2(
+					Interval(time.Millisecond),
+					Map(func(y, _ uint) (string, error) {
+						return fmt.Sprintf("%s%d", x, y), nil
+					}),
+					Take[string](2),
+				)
+			}, 1),
+		), []string{"a0", "a1", "b0", "b1", "c0", "c1"}, nil, true)
+	})
+
+	t.Run("MergeMap with concurrent limit", func(t *testing.T) {
+		var (
+			active    = new(atomic.Int32)
+			maxActive = new(atomic.Int32)
+		)
+		checkObservableHasResults(t, Pipe1(
+			Range[uint](1, 10),
+			MergeMap(func(x uint, _ uint) Observable[uint] {
+				return newObservable(func(subscriber Subscriber[uint]) {
+					n := active.Add(1)
+					defer active.Add(-1)
+					for {
+						m := maxActive.Load()
+						if n <= m || maxActive.CompareAndSwap(m, n) {
+							break
+						}
+					}
+					time.Sleep(time.Millisecond * 5)
+					Next(x).Send(subscriber)
+					Complete[uint]().Send(subscriber)
+				})
+			}, 3),
+		), true, nil, true)
+		require.Equal(t, int32(3), maxActive.Load())
+	})
+
+	t.Run("MergeMapWithConfig with Drop", func(t *testing.T) {
+		stats := new(MergeStats)
+		checkObservableResults(t, Pipe1(
+			Range[uint](1, 4),
+			MergeMapWithConfig(func(x uint, _ uint) Observable[uint] {
+				return Pipe1(Timer[uint](time.Millisecond*20), Map(func(uint, uint) (uint, error) {
+					return x, nil
+				}))
+			}, MergeConfig{Concurrent: 1, MaxQueueSize: 1, Overflow: Drop, Stats: stats}),
+		), []uint{1, 2}, nil, true)
+		require.Equal(t, uint(2), stats.Dropped())
+		require.Equal(t, uint(0), stats.Active())
+		require.Equal(t, uint(0), stats.Queued())
+	})
+
+	t.Run("MergeMapWithConfig with Block", func(t *testing.T) {
+		stats := new(MergeStats)
+		result := make(chan uint, 1)
+		go func() {
+			time.Sleep(time.Millisecond * 10)
+			result <- stats.Queued() + stats.Active()
+		}()
+		checkObservableResults(t, Pipe1(
+			Range[uint](1, 4),
+			MergeMapWithConfig(func(x uint, _ uint) Observable[uint] {
+				return Pipe1(Timer[uint](time.Millisecond*20), Map(func(uint, uint) (uint, error) {
+					return x, nil
+				}))
+			}, MergeConfig{Concurrent: 1, MaxQueueSize: 1, Overflow: Block, Stats: stats}),
+		), []uint{1, 2, 3, 4}, nil, true)
+		require.Equal(t, uint(2), <-result)
+		require.Equal(t, uint(0), stats.Dropped())
+	})
 }
 
 func TestMergeScan(t *testing.T) {
@@ -720,3 +799,154 @@ func TestPairWise(t *testing.T) {
 		}, nil, true)
 	})
 }
+
+func TestMapCtx(t *testing.T) {
+	t.Run("MapCtx with values", func(t *testing.T) {
+		checkObservableResults(t, Pipe1(
+			Range[uint](1, 3),
+			MapCtx(func(ctx context.Context, v, i uint) (string, error) {
+				require.NoError(t, ctx.Err())
+				return fmt.Sprintf("%d:%d", i, v), nil
+			}),
+		), []string{"0:1", "1:2", "2:3"}, nil, true)
+	})
+
+	t.Run("MapCtx with error", func(t *testing.T) {
+		var err = errors.New("failed")
+		checkObservableResults(t, Pipe1(
+			Range[uint](1, 3),
+			MapCtx(func(_ context.Context, v, _ uint) (uint, error) {
+				if v == 2 {
+					return 0, err
+				}
+				return v, nil
+			}),
+		), []uint{1}, err, false)
+	})
+
+	t.Run("MapCtx cancelled on unsubscribe", func(t *testing.T) {
+		var ctxs = make(chan context.Context, 1)
+		checkObservableResults(t, Pipe2(
+			Interval(time.Millisecond),
+			MapCtx(func(ctx context.Context, v, _ uint) (uint, error) {
+				select {
+				case ctxs <- ctx:
+				default:
+				}
+				return v, nil
+			}),
+			Take[uint](1),
+		), []uint{0}, nil, true)
+		ctx := <-ctxs
+		require.Eventually(t, func() bool {
+			return ctx.Err() != nil
+		}, time.Second, time.Millisecond)
+	})
+}
+
+func TestMergeMapCtx(t *testing.T) {
+	t.Run("MergeMapCtx with nil project", func(t *testing.T) {
+		require.PanicsWithValue(t, `rxgo: "MergeMapCtx" expected project func`, func() {
+			MergeMapCtx[uint, uint](nil)
+		})
+	})
+
+	t.Run("MergeMapCtx with values", func(t *testing.T) {
+		checkObservableResults(t, Pipe1(
+			Of2("a", "b"),
+			MergeMapCtx(func(ctx context.Context, v string, _ uint) Observable[string] {
+				require.NoError(t, ctx.Err())
+				return Of2(v)
+			}, 1),
+		), []string{"a", "b"}, nil, true)
+	})
+
+	t.Run("MergeMapCtx cancelled on error", func(t *testing.T) {
+		var (
+			err       = errors.New("failed")
+			cancelled = make(chan struct{})
+		)
+		checkObservableResults(t, Pipe1(
+			Of2[uint](1, 2),
+			MergeMapCtx(func(ctx context.Context, v uint, _ uint) Observable[uint] {
+				if v == 1 {
+					go func() {
+						<-ctx.Done()
+						close(cancelled)
+					}()
+					return Interval(time.Hour)
+				}
+				return Pipe1(Timer[uint](time.Millisecond*10), Map(func(uint, uint) (uint, error) {
+					return 0, err
+				}))
+			}),
+		), []uint{}, err, false)
+		<-cancelled
+	})
+}
+
+func TestConcatMapCtx(t *testing.T) {
+	t.Run("ConcatMapCtx with nil project", func(t *testing.T) {
+		require.PanicsWithValue(t, `rxgo: "ConcatMapCtx" expected project func`, func() {
+			ConcatMapCtx[uint, uint](nil)
+		})
+	})
+
+	t.Run("ConcatMapCtx with values", func(t *testing.T) {
+		var ctxs []context.Context
+		checkObservableResults(t, Pipe1(
+			Of2[uint](1, 2),
+			ConcatMapCtx(func(ctx context.Context, v uint, _ uint) Observable[uint] {
+				ctxs = append(ctxs, ctx)
+				return Range(v*10, 2)
+			}),
+		), []uint{10, 11, 20, 21}, nil, true)
+		require.Len(t, ctxs, 2)
+		for _, ctx := range ctxs {
+			require.Error(t, ctx.Err())
+		}
+	})
+}
+
+func TestExhaustMapCtx(t *testing.T) {
+	t.Run("ExhaustMapCtx with values", func(t *testing.T) {
+		checkObservableResults(t, Pipe1(
+			Of2[uint](1),
+			ExhaustMapCtx(func(ctx context.Context, v uint, _ uint) Observable[uint] {
+				require.NoError(t, ctx.Err())
+				return Of2(v)
+			}),
+		), []uint{1}, nil, true)
+	})
+}
+
+func TestSwitchMapCtx(t *testing.T) {
+	t.Run("SwitchMapCtx with nil project", func(t *testing.T) {
+		require.PanicsWithValue(t, `rxgo: "SwitchMapCtx" expected project func`, func() {
+			SwitchMapCtx[uint, uint](nil)
+		})
+	})
+
+	t.Run("SwitchMapCtx cancels superseded projections", func(t *testing.T) {
+		var cancelled = make(chan uint, 3)
+		checkObservableResults(t, Pipe1(
+			Pipe1(Interval(time.Millisecond*10), Take[uint](3)),
+			SwitchMapCtx(func(ctx context.Context, v uint, _ uint) Observable[uint] {
+				go func() {
+					<-ctx.Done()
+					cancelled <- v
+				}()
+				if v < 2 {
+					return Interval(time.Hour)
+				}
+				return Of2(v)
+			}),
+		), []uint{2}, nil, true)
+
+		var values []uint
+		for i := 0; i < 3; i++ {
+			values = append(values, <-cancelled)
+		}
+		require.ElementsMatch(t, []uint{0, 1, 2}, values)
+	})
+}
@@ -22,3 +22,32 @@ func (p pair[A, B]) Second() B {
 func NewTuple[A any, B any](a A, b B) Tuple[A, B] {
 	return &pair[A, B]{first: a, second: b}
 }
+
+type Tuple3[A any, B any, C any] interface {
+	First() A
+	Second() B
+	Third() C
+}
+
+type triple[A any, B any, C any] struct {
+	first  A
+	second B
+	third  C
+}
+
+func (t triple[A, B, C]) First() A {
+	return t.first
+}
+
+func (t triple[A, B, C]) Second() B {
+	return t.second
+}
+
+func (t triple[A, B, C]) Third() C {
+	return t.third
+}
+
+// Create a tuple using first, second and third value.
+func NewTuple3[A any, B any, C any](a A, b B, c C) Tuple3[A, B, C] {
+	return &triple[A, B, C]{first: a, second: b, third: c}
+}
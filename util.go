@@ -1,8 +1,11 @@
 package rxgo
 
 import (
+	"context"
 	"fmt"
+	"runtime/debug"
 	"sync"
+	"time"
 )
 
 func sendNonBlock[T any](v T, ch chan T) bool {
@@ -168,63 +171,138 @@ func createOperatorFunc[T any, R any](
 	onComplete func(Observer[R]),
 ) Observable[R] {
 	return newObservable(func(subscriber Subscriber[R]) {
-		var (
-			wg   = new(sync.WaitGroup)
-			stop bool
-		)
-
-		wg.Add(1)
-
-		var (
-			upStream = source.SubscribeOn(wg.Done)
-		)
-
-		obs := &consumerObserver[R]{
-			onNext: func(v R) {
-				Next(v).Send(subscriber)
-			},
-			onError: func(err error) {
-				upStream.Stop()
-				stop = true
-				Error[R](err).Send(subscriber)
-			},
-			onComplete: func() {
-				// Inform the up stream to stop emit value
-				upStream.Stop()
-				stop = true
-				Complete[R]().Send(subscriber)
-			},
-		}
+		observeOperator(subscriber, source, func(obs Observer[R], v T, _ uint) {
+			onNext(obs, v)
+		}, onError, onComplete)
+	})
+}
+
+// createOperatorCtxFunc is the same as `createOperatorFunc`, but `onNext`
+// receives the index of the value and a context which is cancelled when the
+// subscriber stops or the stream terminates.
+func createOperatorCtxFunc[T any, R any](
+	source Observable[T],
+	onNext func(ctx context.Context, obs Observer[R], v T, index uint),
+	onError func(Observer[R], error),
+	onComplete func(Observer[R]),
+) Observable[R] {
+	return newObservable(func(subscriber Subscriber[R]) {
+		ctx, cancel := subscriberContext(subscriber)
+		defer cancel()
+
+		observeOperator(subscriber, source, func(obs Observer[R], v T, index uint) {
+			onNext(ctx, obs, v, index)
+		}, onError, onComplete)
+	})
+}
+
+func observeOperator[T any, R any](
+	subscriber Subscriber[R],
+	source Observable[T],
+	onNext func(Observer[R], T, uint),
+	onError func(Observer[R], error),
+	onComplete func(Observer[R]),
+) {
+	var (
+		wg    = new(sync.WaitGroup)
+		stop  bool
+		index uint
+		stage = stageOf(subscriber)
+	)
+
+	wg.Add(1)
+
+	var (
+		upStream = source.SubscribeOn(wg.Done)
+	)
+
+	obs := &consumerObserver[R]{
+		onNext: func(v R) {
+			Next(v).Send(subscriber)
+		},
+		onError: func(err error) {
+			upStream.Stop()
+			stop = true
+			Error[R](err).Send(subscriber)
+		},
+		onComplete: func() {
+			// Inform the up stream to stop emit value
+			upStream.Stop()
+			stop = true
+			Complete[R]().Send(subscriber)
+		},
+	}
+
+	for !stop {
+		select {
+		// If only the stream terminated, break it
+		case <-subscriber.Closed():
+			stop = true
+			upStream.Stop()
+			return
 
-		for !stop {
-			select {
-			// If only the stream terminated, break it
-			case <-subscriber.Closed():
+		case item, ok := <-upStream.ForEach():
+			if !ok {
+				// If only the data stream closed, break it
 				stop = true
-				upStream.Stop()
 				return
+			}
 
-			case item, ok := <-upStream.ForEach():
-				if !ok {
-					// If only the data stream closed, break it
-					stop = true
-					return
-				}
+			if err := item.Err(); err != nil {
+				onError(obs, err)
+				return
+			}
 
-				if err := item.Err(); err != nil {
-					onError(obs, err)
-					return
-				}
+			if item.Done() {
+				onComplete(obs)
+				return
+			}
+
+			trackItem(subscriber, index, item.Value())
 
-				if item.Done() {
-					onComplete(obs)
-					return
-				}
+			var start time.Time
+			if stage != nil {
+				start = stage.begin()
+			}
+
+			// a panic in the user callback stops the upstream as any error would
+			if err := catchPanic(func() {
+				onNext(obs, item.Value(), index)
+			}); err != nil {
+				obs.Error(err)
+			}
+			index++
 
-				onNext(obs, item.Value())
+			if stage != nil {
+				stage.end(start)
 			}
 		}
+	}
 
-		wg.Wait()
-	})
+	wg.Wait()
+}
+
+func newPanicError(v any) PanicError {
+	err := PanicError{Value: v, Stack: debug.Stack()}
+	onPanic(err)
+	return err
+}
+
+// recoverAsError must be deferred, it converts a panic into an `Error`
+// notification carrying a `PanicError`.
+func recoverAsError[T any](subscriber Subscriber[T]) {
+	if r := recover(); r != nil {
+		Error[T](newPanicError(r)).Send(subscriber)
+	}
+}
+
+// catchPanic calls the function, converting a panic into a `PanicError`.
+func catchPanic(fn func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = newPanicError(r)
+		}
+	}()
+	fn()
+	return nil
 }